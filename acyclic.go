@@ -0,0 +1,96 @@
+package validate
+
+import (
+	"errors"
+	"sort"
+	"strings"
+)
+
+// ErrCycle is the error that returns when a dependency graph contains a cycle.
+var ErrCycle = NewError("validation_cycle", "dependency cycle detected: {{.cycle}}")
+
+// Acyclic returns a validation rule that checks if a dependency graph given as an adjacency list of type
+// map[string][]string contains no cycles, i.e. if it is a directed acyclic graph. Each key lists the nodes it
+// depends on; dependencies that are not keys of the map are treated as nodes without dependencies.
+// The first cycle found is reported in the "cycle" parameter of the error, e.g. "a -> b -> a".
+// Nodes are visited in sorted order, so the reported cycle is deterministic.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func Acyclic() AcyclicRule {
+	return AcyclicRule{
+		err: ErrCycle,
+	}
+}
+
+// AcyclicRule is a validation rule that checks if a dependency graph contains no cycles.
+type AcyclicRule struct {
+	err Error
+}
+
+// Validate checks if the given value is valid or not.
+func (r AcyclicRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	graph, ok := value.(map[string][]string)
+	if !ok {
+		return errors.New("must be a map of string slices")
+	}
+
+	nodes := make([]string, 0, len(graph))
+	for node := range graph {
+		nodes = append(nodes, node)
+	}
+	sort.Strings(nodes)
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := map[string]int{}
+	var path []string
+	var visit func(node string) []string
+	visit = func(node string) []string {
+		switch state[node] {
+		case visiting:
+			for i, n := range path {
+				if n == node {
+					return append(append([]string{}, path[i:]...), node)
+				}
+			}
+		case visited:
+			return nil
+		}
+		state[node] = visiting
+		path = append(path, node)
+		for _, dep := range graph[node] {
+			if cycle := visit(dep); cycle != nil {
+				return cycle
+			}
+		}
+		path = path[:len(path)-1]
+		state[node] = visited
+		return nil
+	}
+
+	for _, node := range nodes {
+		if cycle := visit(node); cycle != nil {
+			return r.err.SetParams(map[string]interface{}{"cycle": strings.Join(cycle, " -> ")})
+		}
+	}
+	return nil
+}
+
+// Error sets the error message for the rule.
+func (r AcyclicRule) Error(message string) AcyclicRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r AcyclicRule) ErrorObject(err Error) AcyclicRule {
+	r.err = err
+	return r
+}
@@ -0,0 +1,47 @@
+package validate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAcyclic(t *testing.T) {
+	var nilMap map[string][]string
+
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", nilMap, ""},
+		{"t2", map[string][]string{}, ""},
+		{"t3", map[string][]string{"a": {"b", "c"}, "b": {"c"}, "c": nil}, ""},
+		{"t4", map[string][]string{"a": {"b"}, "b": {"external"}}, ""},
+		{"t5", map[string][]string{"a": {"b"}, "b": {"a"}}, "dependency cycle detected: a -> b -> a"},
+		{"t6", map[string][]string{"a": {"a"}}, "dependency cycle detected: a -> a"},
+		{"t7", map[string][]string{"x": {"b"}, "b": {"c"}, "c": {"d"}, "d": {"b"}}, "dependency cycle detected: b -> c -> d -> b"},
+		{"t8", &map[string][]string{"a": {"b"}, "b": {"a"}}, "dependency cycle detected: a -> b -> a"},
+		{"t9", map[string]string{"a": "b"}, "must be a map of string slices"},
+	}
+
+	for _, test := range tests {
+		err := Acyclic().Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestAcyclicRule_Error(t *testing.T) {
+	r := Acyclic().Error("cycle: {{.cycle}}")
+	assert.Equal(t, "cycle: a -> a", r.Validate(map[string][]string{"a": {"a"}}).Error())
+}
+
+func TestAcyclicRule_ErrorObject(t *testing.T) {
+	r := Acyclic()
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
@@ -0,0 +1,117 @@
+package validate
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+)
+
+// ErrAlignedTo is the error that returns when a value is not aligned to a boundary.
+var ErrAlignedTo = NewError("validation_aligned_to", "must be aligned to {{.boundary}} bytes")
+
+var reByteSize = regexp.MustCompile(`^(\d+)\s*([KMGTPE]i?B?|B)?$`)
+
+// byteSizeUnits are the multipliers of the decimal and binary byte size units.
+var byteSizeUnits = map[string]int64{
+	"K": 1e3, "M": 1e6, "G": 1e9, "T": 1e12, "P": 1e15, "E": 1e18,
+	"Ki": 1 << 10, "Mi": 1 << 20, "Gi": 1 << 30, "Ti": 1 << 40, "Pi": 1 << 50, "Ei": 1 << 60,
+}
+
+// AlignedTo returns a validation rule that checks if a number of bytes is a multiple of the given boundary,
+// e.g. AlignedTo(4096) for memory pages. The value may be an int or uint, or a string holding a byte size
+// as accepted by ParseByteSize. A boundary that is not positive results in an internal error.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func AlignedTo(boundary int64) AlignedRule {
+	return AlignedRule{
+		boundary: boundary,
+		err:      ErrAlignedTo,
+	}
+}
+
+// AlignedToSize is like AlignedTo but takes a human-readable boundary such as "4Ki".
+// The function panics if size is not a valid byte size.
+func AlignedToSize(size string) AlignedRule {
+	boundary, err := ParseByteSize(size)
+	if err != nil {
+		panic(err)
+	}
+	return AlignedTo(boundary)
+}
+
+// ParseByteSize parses a byte size, which is a non-negative integer followed by an optional unit:
+// B, a decimal unit (K, M, G, T, P, E) or a binary unit (Ki, Mi, Gi, Ti, Pi, Ei), optionally suffixed with B.
+// For example, "512", "4Ki", "4KiB" and "10MB" are valid byte sizes.
+func ParseByteSize(size string) (int64, error) {
+	m := reByteSize.FindStringSubmatch(size)
+	if m == nil {
+		return 0, fmt.Errorf("invalid byte size %q", size)
+	}
+	n, err := strconv.ParseInt(m[1], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid byte size %q", size)
+	}
+	unit := m[2]
+	if unit == "" || unit == "B" {
+		return n, nil
+	}
+	if unit[len(unit)-1] == 'B' {
+		unit = unit[:len(unit)-1]
+	}
+	multiplier := byteSizeUnits[unit]
+	if n > math.MaxInt64/multiplier {
+		return 0, fmt.Errorf("byte size %q is too large", size)
+	}
+	return n * multiplier, nil
+}
+
+// AlignedRule is a validation rule that checks if a number of bytes is aligned to a boundary.
+type AlignedRule struct {
+	boundary int64
+	err      Error
+}
+
+// Validate checks if the given value is valid or not.
+func (r AlignedRule) Validate(value interface{}) error {
+	if r.boundary <= 0 {
+		return NewInternalError(errors.New("alignment boundary must be positive"))
+	}
+
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	var aligned bool
+	if s, ok := value.(string); ok {
+		n, err := ParseByteSize(s)
+		if err != nil {
+			return err
+		}
+		aligned = n%r.boundary == 0
+	} else if v, err := ToInt(value); err == nil {
+		aligned = v%r.boundary == 0
+	} else if u, uerr := ToUint(value); uerr == nil {
+		aligned = u%uint64(r.boundary) == 0
+	} else {
+		return err
+	}
+
+	if aligned {
+		return nil
+	}
+	return r.err.SetParams(map[string]interface{}{"boundary": r.boundary})
+}
+
+// Error sets the error message for the rule.
+func (r AlignedRule) Error(message string) AlignedRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r AlignedRule) ErrorObject(err Error) AlignedRule {
+	r.err = err
+	return r
+}
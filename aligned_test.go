@@ -0,0 +1,97 @@
+package validate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAlignedTo(t *testing.T) {
+	var nilPtr *int64
+	offset := int64(8192)
+
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", 0, ""},
+		{"t2", nilPtr, ""},
+		{"t3", 4096, ""},
+		{"t4", &offset, ""},
+		{"t5", 4097, "must be aligned to 4096 bytes"},
+		{"t6", -4096, ""},
+		{"t7", uint64(1 << 63), ""},
+		{"t8", uint32(100), "must be aligned to 4096 bytes"},
+		{"t9", "16Ki", ""},
+		{"t10", "16KB", "must be aligned to 4096 bytes"},
+		{"t11", "16 XB", `invalid byte size "16 XB"`},
+		{"t12", 4096.0, "cannot convert float64 to int64"},
+	}
+
+	for _, test := range tests {
+		err := AlignedTo(4096).Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	for _, boundary := range []int64{0, -1} {
+		err := AlignedTo(boundary).Validate(4096)
+		if assert.NotNil(t, err) {
+			_, ok := err.(InternalError)
+			assert.True(t, ok)
+		}
+	}
+}
+
+func TestAlignedToSize(t *testing.T) {
+	r := AlignedToSize("4Ki")
+	assert.Nil(t, r.Validate(8192))
+	assert.EqualError(t, r.Validate(1000), "must be aligned to 4096 bytes")
+
+	assert.Panics(t, func() { AlignedToSize("4k") })
+}
+
+func TestParseByteSize(t *testing.T) {
+	tests := []struct {
+		size  string
+		bytes int64
+		err   string
+	}{
+		{"0", 0, ""},
+		{"512", 512, ""},
+		{"512B", 512, ""},
+		{"4Ki", 4096, ""},
+		{"4KiB", 4096, ""},
+		{"4 KiB", 4096, ""},
+		{"10MB", 10000000, ""},
+		{"2Gi", 2 << 30, ""},
+		{"7Ei", 7 << 60, ""},
+		{"8Ei", 0, `byte size "8Ei" is too large`},
+		{"99999999999999999999", 0, `invalid byte size "99999999999999999999"`},
+		{"1.5Ki", 0, `invalid byte size "1.5Ki"`},
+		{"-1", 0, `invalid byte size "-1"`},
+		{"4kb", 0, `invalid byte size "4kb"`},
+		{"", 0, `invalid byte size ""`},
+	}
+
+	for _, test := range tests {
+		n, err := ParseByteSize(test.size)
+		assertError(t, test.err, err, test.size)
+		assert.Equal(t, test.bytes, n, test.size)
+	}
+}
+
+func TestAlignedRule_Error(t *testing.T) {
+	r := AlignedTo(8).Error("must be a multiple of {{.boundary}}")
+	assert.Equal(t, "must be a multiple of 8", r.Validate(12).Error())
+}
+
+func TestAlignedRule_ErrorObject(t *testing.T) {
+	r := AlignedTo(8)
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
@@ -0,0 +1,65 @@
+package validate
+
+var (
+	// ErrAllOrNone is the error that returns when only some fields of a group are provided.
+	ErrAllOrNone = NewError("validation_all_or_none", "fields must be all provided or all empty")
+	// ErrAllOrNoneGroup is the error that returns when only some fields of a named group are provided.
+	ErrAllOrNoneGroup = NewError("validation_all_or_none_group", "{{.group}} fields must be all provided or all empty")
+)
+
+// AllOrNone returns a validation rule that checks if the fields referenced by fieldPtrs are either all
+// non-empty or all empty, e.g. the street, city and zip of an optional address. The rule ignores the value
+// being validated, so it can be attached to any field within ValidateStruct. Call Group to name the group
+// in the error message:
+//    validation.Field(&a.Street, validation.AllOrNone(&a.Street, &a.City, &a.Zip).Group("address"))
+//
+// Emptiness is determined the same way as for the Required rule.
+func AllOrNone(fieldPtrs ...interface{}) AllOrNoneRule {
+	return AllOrNoneRule{
+		fields: fieldPtrs,
+		err:    ErrAllOrNone,
+	}
+}
+
+// AllOrNoneRule is a validation rule that checks if a group of fields is all provided or all empty.
+type AllOrNoneRule struct {
+	fields []interface{}
+	group  string
+	err    Error
+}
+
+// Group sets the name of the group, which is reported in the "group" parameter of the error,
+// e.g. "address fields must be all provided or all empty".
+func (r AllOrNoneRule) Group(name string) AllOrNoneRule {
+	r.group = name
+	if r.err.Code() == ErrAllOrNone.Code() && r.err.Message() == ErrAllOrNone.Message() {
+		r.err = ErrAllOrNoneGroup
+	}
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r AllOrNoneRule) Validate(interface{}) error {
+	provided := 0
+	for _, field := range r.fields {
+		if !isNilOrEmpty(field) {
+			provided++
+		}
+	}
+	if provided == 0 || provided == len(r.fields) {
+		return nil
+	}
+	return r.err.SetParams(map[string]interface{}{"group": r.group})
+}
+
+// Error sets the error message for the rule.
+func (r AllOrNoneRule) Error(message string) AllOrNoneRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r AllOrNoneRule) ErrorObject(err Error) AllOrNoneRule {
+	r.err = err
+	return r
+}
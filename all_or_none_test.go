@@ -0,0 +1,58 @@
+package validate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAllOrNone(t *testing.T) {
+	type address struct {
+		Street string
+		City   string
+		Zip    *string
+	}
+	zip := "12345"
+	empty := ""
+
+	tests := []struct {
+		tag     string
+		address address
+		err     string
+	}{
+		{"t1", address{}, ""},
+		{"t2", address{Zip: &empty}, ""},
+		{"t3", address{"Main St", "Springfield", &zip}, ""},
+		{"t4", address{Street: "Main St"}, "Street: address fields must be all provided or all empty."},
+		{"t5", address{Zip: &zip}, "Street: address fields must be all provided or all empty."},
+		{"t6", address{"Main St", "Springfield", &empty}, "Street: address fields must be all provided or all empty."},
+	}
+
+	for _, test := range tests {
+		a := test.address
+		err := ValidateStruct(&a,
+			Field(&a.Street, AllOrNone(&a.Street, &a.City, &a.Zip).Group("address")),
+		)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	a, b := "a", ""
+	assert.EqualError(t, AllOrNone(&a, &b).Validate(nil), "fields must be all provided or all empty")
+	assert.Nil(t, AllOrNone().Validate(nil))
+}
+
+func TestAllOrNoneRule_Error(t *testing.T) {
+	a, b := "a", ""
+	r := AllOrNone(&a, &b).Error("complete the {{.group}}").Group("form")
+	assert.Equal(t, "complete the form", r.Validate(nil).Error())
+}
+
+func TestAllOrNoneRule_ErrorObject(t *testing.T) {
+	r := AllOrNone()
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
@@ -0,0 +1,67 @@
+package validate
+
+import "sort"
+
+// ErrUnbalancedSizes is the error that returns when the largest element of a batch is too large compared to the median.
+var ErrUnbalancedSizes = NewError("validation_unbalanced_sizes", "items are too unevenly sized")
+
+// BalancedSizes returns a validation rule that checks if no element of a slice or array dominates the others
+// in size: the largest size returned by extractor must be at most maxRatio times the median size.
+// For an even number of elements, the median is the mean of the two middle sizes.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func BalancedSizes(extractor func(interface{}) int, maxRatio float64) BalancedSizesRule {
+	return BalancedSizesRule{
+		extractor: extractor,
+		maxRatio:  maxRatio,
+		err:       ErrUnbalancedSizes,
+	}
+}
+
+// BalancedSizesRule is a validation rule that checks if the sizes of the elements of a slice are balanced.
+type BalancedSizesRule struct {
+	extractor func(interface{}) int
+	maxRatio  float64
+	err       Error
+}
+
+// Validate checks if the given value is valid or not.
+func (r BalancedSizesRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	v, err := sliceValue(value)
+	if err != nil {
+		return err
+	}
+
+	sizes := make([]int, v.Len())
+	for i := range sizes {
+		sizes[i] = r.extractor(v.Index(i).Interface())
+	}
+	sort.Ints(sizes)
+
+	n := len(sizes)
+	median := float64(sizes[n/2])
+	if n%2 == 0 {
+		median = float64(sizes[n/2-1]+sizes[n/2]) / 2
+	}
+
+	if float64(sizes[n-1]) > r.maxRatio*median {
+		return r.err.SetParams(map[string]interface{}{"ratio": r.maxRatio})
+	}
+	return nil
+}
+
+// Error sets the error message for the rule.
+func (r BalancedSizesRule) Error(message string) BalancedSizesRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r BalancedSizesRule) ErrorObject(err Error) BalancedSizesRule {
+	r.err = err
+	return r
+}
@@ -0,0 +1,50 @@
+package validate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBalancedSizes(t *testing.T) {
+	size := func(v interface{}) int { return len(v.(string)) }
+	var nilSlice []string
+
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", nilSlice, ""},
+		{"t2", []string{}, ""},
+		{"t3", []string{"abc"}, ""},
+		{"t4", []string{"ab", "abc", "abcdef"}, ""},
+		{"t5", []string{"ab", "abc", "abcdefg"}, "items are too unevenly sized"},
+		{"t6", []string{"a", "ab", "abc", "abcde"}, ""},
+		{"t7", []string{"a", "ab", "abc", "abcdef"}, "items are too unevenly sized"},
+		{"t8", []string{"", "", "a"}, "items are too unevenly sized"},
+		{"t9", &[]string{"abc", "abcd"}, ""},
+		{"t10", "abc", "must be a slice or an array"},
+	}
+
+	for _, test := range tests {
+		err := BalancedSizes(size, 2).Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestBalancedSizesRule_Error(t *testing.T) {
+	size := func(v interface{}) int { return v.(int) }
+	r := BalancedSizes(size, 1.5).Error("no item may exceed {{.ratio}}x the median")
+	assert.Equal(t, "no item may exceed 1.5x the median", r.Validate([]int{2, 2, 4}).Error())
+}
+
+func TestBalancedSizesRule_ErrorObject(t *testing.T) {
+	r := BalancedSizes(nil, 2)
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
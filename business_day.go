@@ -0,0 +1,81 @@
+package validate
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// ErrBusinessDay is the error that returns when a time does not fall on a business day.
+var ErrBusinessDay = NewError("validation_business_day", "must be a business day")
+
+// BusinessDay returns a validation rule that checks if a time.Time value falls on a business day,
+// i.e. a day from Monday to Friday that is not one of the given holidays.
+// The value and the holidays are compared by their calendar date in the given location, ignoring
+// the time of day. If loc is nil, UTC is used.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func BusinessDay(holidays []time.Time, loc *time.Location) BusinessDayRule {
+	if loc == nil {
+		loc = time.UTC
+	}
+	days := make(map[civilDate]bool, len(holidays))
+	for _, h := range holidays {
+		days[dateIn(h, loc)] = true
+	}
+	return BusinessDayRule{
+		holidays: days,
+		loc:      loc,
+		err:      ErrBusinessDay,
+	}
+}
+
+// BusinessDayRule is a validation rule that checks if a time falls on a business day.
+type BusinessDayRule struct {
+	holidays map[civilDate]bool
+	loc      *time.Location
+	err      Error
+}
+
+// Validate checks if the given value is valid or not.
+func (r BusinessDayRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	t, ok := value.(time.Time)
+	if !ok {
+		return fmt.Errorf("cannot convert %v to time.Time", reflect.TypeOf(value))
+	}
+
+	t = t.In(r.loc)
+	if wd := t.Weekday(); wd == time.Saturday || wd == time.Sunday || r.holidays[dateIn(t, r.loc)] {
+		return r.err
+	}
+	return nil
+}
+
+// Error sets the error message for the rule.
+func (r BusinessDayRule) Error(message string) BusinessDayRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r BusinessDayRule) ErrorObject(err Error) BusinessDayRule {
+	r.err = err
+	return r
+}
+
+// civilDate is a calendar date without a time of day.
+type civilDate struct {
+	year  int
+	month time.Month
+	day   int
+}
+
+// dateIn returns the calendar date of t in the given location.
+func dateIn(t time.Time, loc *time.Location) civilDate {
+	y, m, d := t.In(loc).Date()
+	return civilDate{y, m, d}
+}
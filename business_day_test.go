@@ -0,0 +1,65 @@
+package validate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBusinessDay(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*60*60)
+	holidays := []time.Time{
+		time.Date(2024, 12, 25, 0, 0, 0, 0, time.UTC),
+		// 2025-01-01 in Tokyo, 2024-12-31 in UTC
+		time.Date(2024, 12, 31, 20, 0, 0, 0, time.UTC),
+	}
+	var nilTime *time.Time
+	monday := time.Date(2024, 12, 23, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		tag   string
+		loc   *time.Location
+		value interface{}
+		err   string
+	}{
+		{"t1", nil, time.Time{}, ""},
+		{"t2", nil, nilTime, ""},
+		{"t3", nil, monday, ""},
+		{"t4", nil, &monday, ""},
+		{"t5", nil, time.Date(2024, 12, 21, 10, 0, 0, 0, time.UTC), "must be a business day"},
+		{"t6", nil, time.Date(2024, 12, 22, 10, 0, 0, 0, time.UTC), "must be a business day"},
+		{"t7", nil, time.Date(2024, 12, 25, 23, 59, 0, 0, time.UTC), "must be a business day"},
+		{"t8", nil, time.Date(2024, 12, 31, 10, 0, 0, 0, time.UTC), "must be a business day"},
+		{"t9", tokyo, time.Date(2024, 12, 31, 10, 0, 0, 0, tokyo), ""},
+		{"t10", tokyo, time.Date(2025, 1, 1, 10, 0, 0, 0, tokyo), "must be a business day"},
+		// Friday 20:00 UTC is already Saturday in Tokyo
+		{"t11", tokyo, time.Date(2024, 12, 20, 20, 0, 0, 0, time.UTC), "must be a business day"},
+		{"t12", nil, "2024-12-23", "cannot convert string to time.Time"},
+	}
+
+	for _, test := range tests {
+		r := BusinessDay(holidays, test.loc)
+		err := r.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestBusinessDayRule_Error(t *testing.T) {
+	r := BusinessDay(nil, nil)
+	saturday := time.Date(2024, 12, 21, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, "must be a business day", r.Validate(saturday).Error())
+
+	r = r.Error("123")
+	assert.Equal(t, "123", r.err.Message())
+}
+
+func TestBusinessDayRule_ErrorObject(t *testing.T) {
+	r := BusinessDay(nil, nil)
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
@@ -0,0 +1,98 @@
+package validate
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// ErrChronological is the error that returns when timestamps are not in chronological order.
+var ErrChronological = NewError("validation_chronological", "{{.field}} cannot be before {{.previous}}")
+
+// ChronologicalFields returns a validation rule that checks if the time.Time fields referenced by fieldPtrs
+// are in chronological order, e.g. the stages of an order lifecycle. Each timestamp must not be before the
+// previous one that is set; nil pointers and zero times are skipped, which allows for stages that have not
+// happened yet. The rule ignores the value being validated, so it can be attached to any field within ValidateStruct:
+//    validation.Field(&o.DeliveredAt, validation.ChronologicalFields(&o.CreatedAt, &o.PaidAt, &o.ShippedAt, &o.DeliveredAt))
+//
+// The violating fields are reported in the "field" and "previous" parameters of the error, e.g.
+// "shippedAt cannot be before paidAt". Within ValidateStruct they are named like the error keys;
+// otherwise they are named by their positions, e.g. "field #2".
+func ChronologicalFields(fieldPtrs ...interface{}) ChronologicalRule {
+	return ChronologicalRule{
+		fields: fieldPtrs,
+		err:    ErrChronological,
+	}
+}
+
+// ChronologicalRule is a validation rule that checks if timestamps are in chronological order.
+type ChronologicalRule struct {
+	fields []interface{}
+	names  []string
+	err    Error
+}
+
+func (r ChronologicalRule) bindStruct(structPtr interface{}, tagName string) Rule {
+	value := reflect.ValueOf(structPtr)
+	if value.Kind() != reflect.Ptr || value.IsNil() {
+		return r
+	}
+	r.names = make([]string, len(r.fields))
+	for i, field := range r.fields {
+		fv := reflect.ValueOf(field)
+		if fv.Kind() != reflect.Ptr {
+			continue
+		}
+		if ft := findStructField(value.Elem(), fv); ft != nil {
+			r.names[i] = getErrorFieldName(ft, tagName)
+		}
+	}
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r ChronologicalRule) Validate(interface{}) error {
+	var prev time.Time
+	prevIndex := -1
+	for i, field := range r.fields {
+		value, isNil := Indirect(field)
+		if isNil {
+			continue
+		}
+		t, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("cannot convert %v to time.Time", reflect.TypeOf(value))
+		}
+		if t.IsZero() {
+			continue
+		}
+		if prevIndex >= 0 && t.Before(prev) {
+			return r.err.SetParams(map[string]interface{}{
+				"field":    r.name(i),
+				"previous": r.name(prevIndex),
+			})
+		}
+		prev, prevIndex = t, i
+	}
+	return nil
+}
+
+// name returns the name of the i-th field.
+func (r ChronologicalRule) name(i int) string {
+	if i < len(r.names) && r.names[i] != "" {
+		return r.names[i]
+	}
+	return fmt.Sprintf("field #%v", i)
+}
+
+// Error sets the error message for the rule.
+func (r ChronologicalRule) Error(message string) ChronologicalRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r ChronologicalRule) ErrorObject(err Error) ChronologicalRule {
+	r.err = err
+	return r
+}
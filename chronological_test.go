@@ -0,0 +1,68 @@
+package validate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChronologicalFields(t *testing.T) {
+	type order struct {
+		CreatedAt   time.Time  `json:"createdAt"`
+		PaidAt      *time.Time `json:"paidAt"`
+		ShippedAt   *time.Time `json:"shippedAt"`
+		DeliveredAt *time.Time
+	}
+	day := func(d int) *time.Time {
+		t := time.Date(2020, 1, d, 0, 0, 0, 0, time.UTC)
+		return &t
+	}
+
+	tests := []struct {
+		tag   string
+		order order
+		err   string
+	}{
+		{"t1", order{}, ""},
+		{"t2", order{CreatedAt: *day(1)}, ""},
+		{"t3", order{*day(1), day(2), day(3), day(4)}, ""},
+		{"t4", order{*day(1), day(1), day(1), day(1)}, ""},
+		{"t5", order{*day(1), day(3), day(2), nil}, "DeliveredAt: shippedAt cannot be before paidAt."},
+		{"t6", order{*day(2), day(1), nil, nil}, "DeliveredAt: paidAt cannot be before createdAt."},
+		{"t7", order{*day(1), day(3), nil, day(2)}, "DeliveredAt: DeliveredAt cannot be before paidAt."},
+		{"t8", order{*day(5), nil, nil, day(2)}, "DeliveredAt: DeliveredAt cannot be before createdAt."},
+		{"t9", order{time.Time{}, day(3), day(2), nil}, "DeliveredAt: shippedAt cannot be before paidAt."},
+	}
+
+	for _, test := range tests {
+		o := test.order
+		err := ValidateStruct(&o,
+			Field(&o.DeliveredAt, ChronologicalFields(&o.CreatedAt, &o.PaidAt, &o.ShippedAt, &o.DeliveredAt)),
+		)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	// outside ValidateStruct, fields are named by their positions
+	a, b := day(2), day(1)
+	assert.EqualError(t, ChronologicalFields(&a, &b).Validate(nil), "field #1 cannot be before field #0")
+
+	s := "2020-01-01"
+	assert.EqualError(t, ChronologicalFields(a, &s).Validate(nil), "cannot convert string to time.Time")
+}
+
+func TestChronologicalRule_Error(t *testing.T) {
+	a, b := time.Unix(2, 0), time.Unix(1, 0)
+	r := ChronologicalFields(&a, &b).Error("{{.previous}} must come first")
+	assert.Equal(t, "field #0 must come first", r.Validate(nil).Error())
+}
+
+func TestChronologicalRule_ErrorObject(t *testing.T) {
+	r := ChronologicalFields()
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
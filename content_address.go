@@ -0,0 +1,100 @@
+package validate
+
+import (
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// errContentAddressOutsideStruct is the error that a ContentAddress rule is not evaluated by ValidateStruct.
+var errContentAddressOutsideStruct = errors.New("ContentAddress rules can only be used with ValidateStruct")
+
+// ErrContentAddress is the error that returns when an ID does not match the hash of the content it identifies.
+var ErrContentAddress = NewError("validation_content_address", "id does not match content hash")
+
+// ContentAddress returns a validation rule that checks if the hex-encoded ID referenced by idPtr equals the hash
+// of the canonical form of a struct. The rule must be passed directly to Field; ValidateStruct then calls
+// canonicalize with the struct pointer it was given, which the function may type-assert to the concrete pointer
+// type, and hashes the returned bytes. The algorithm can be "sha256", "sha1" or "md5", as for DigestMatches.
+// The rule is usually attached to the ID field:
+//    validation.Field(&o.ID, validation.ContentAddress(&o.ID, func(s interface{}) []byte {
+//        return s.(*Object).Canonical()
+//    }, "sha256"))
+//
+// If idPtr is nil, the value the rule is attached to is used as the ID. Since canonicalize does not refer to
+// a particular struct either, such a rule can be reused for any number of structs, e.g. with StructRules or
+// in a package-level variable.
+//
+// The ID is compared case-insensitively. If the algorithm is unknown, the rule returns an internal error.
+// The rule is skipped if the ID is empty. Use the Required rule to make sure an ID is present.
+// Evaluating the rule outside ValidateStruct results in an internal error.
+func ContentAddress(idPtr interface{}, canonicalize func(structPtr interface{}) []byte, algo string) ContentAddressRule {
+	return ContentAddressRule{
+		id:           idPtr,
+		canonicalize: canonicalize,
+		algo:         strings.ToLower(algo),
+		err:          ErrContentAddress,
+	}
+}
+
+// ContentAddressRule is a validation rule that checks if an ID matches the hash of the content it identifies.
+type ContentAddressRule struct {
+	id           interface{}
+	canonicalize func(structPtr interface{}) []byte
+	structPtr    interface{}
+	algo         string
+	err          Error
+}
+
+func (r ContentAddressRule) bindStruct(structPtr interface{}, _ string) Rule {
+	r.structPtr = structPtr
+	return r
+}
+
+// Validate checks if the ID matches the content hash.
+func (r ContentAddressRule) Validate(value interface{}) error {
+	if r.structPtr == nil {
+		return NewInternalError(errContentAddressOutsideStruct)
+	}
+
+	if r.id != nil {
+		value = r.id
+	}
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	id, err := EnsureString(value)
+	if err != nil {
+		return err
+	}
+
+	newHash, ok := digestAlgorithms[r.algo]
+	if !ok {
+		return NewInternalError(fmt.Errorf("unsupported digest algorithm %q", r.algo))
+	}
+
+	h := newHash()
+	h.Write(r.canonicalize(r.structPtr))
+	actual := hex.EncodeToString(h.Sum(nil))
+
+	if subtle.ConstantTimeCompare([]byte(actual), []byte(strings.ToLower(id))) == 1 {
+		return nil
+	}
+	return r.err
+}
+
+// Error sets the error message for the rule.
+func (r ContentAddressRule) Error(message string) ContentAddressRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r ContentAddressRule) ErrorObject(err Error) ContentAddressRule {
+	r.err = err
+	return r
+}
@@ -0,0 +1,93 @@
+package validate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type contentObject struct {
+	ID   string
+	Body string
+}
+
+func (o *contentObject) canonical() []byte {
+	return []byte("body=" + o.Body)
+}
+
+func canonicalContent(s interface{}) []byte {
+	return s.(*contentObject).canonical()
+}
+
+func TestContentAddress(t *testing.T) {
+	// sha256("body=hello")
+	const hash = "2747d1fe11d16ad9472d8552237257086e5485aeaa69cc1317a36714ab2461b4"
+
+	tests := []struct {
+		tag  string
+		id   string
+		body string
+		algo string
+		err  string
+	}{
+		{"t1", "", "hello", "sha256", ""},
+		{"t2", hash, "hello", "sha256", ""},
+		{"t3", hash, "hello!", "sha256", "ID: id does not match content hash."},
+		{"t4", "abc", "hello", "sha256", "ID: id does not match content hash."},
+		{"t5", hash, "hello", "SHA256", ""},
+		{"t6", hash, "hello", "crc32", "unsupported digest algorithm \"crc32\""},
+	}
+
+	for _, test := range tests {
+		o := contentObject{ID: test.id, Body: test.body}
+		err := ValidateStruct(&o, Field(&o.ID, ContentAddress(&o.ID, canonicalContent, test.algo)))
+		assertError(t, test.err, err, test.tag)
+		err = ValidateStruct(&o, Field(&o.ID, ContentAddress(nil, canonicalContent, test.algo)))
+		assertError(t, test.err, err, test.tag)
+	}
+
+	// the ID may be referenced from another field
+	o := struct {
+		contentObject
+		Title string
+	}{contentObject{ID: "abc", Body: "hello"}, "x"}
+	err := ValidateStruct(&o, Field(&o.Title, ContentAddress(&o.ID, func(s interface{}) []byte {
+		return []byte("body=hello")
+	}, "sha256")))
+	assert.EqualError(t, err, "Title: id does not match content hash.")
+
+	n := 1
+	assert.EqualError(t, ValidateStruct(&o, Field(&o.Title, ContentAddress(&n, nil, "sha256"))), "Title: must be either a string or byte slice.")
+
+	// outside ValidateStruct, the rule results in an internal error
+	_, ok := ContentAddress(nil, canonicalContent, "sha256").Validate(hash).(InternalError)
+	assert.True(t, ok)
+}
+
+func TestContentAddress_Reuse(t *testing.T) {
+	// sha1("body=hello")
+	var tmpl contentObject
+	rules := NewStructRules(&tmpl, Field(&tmpl.ID, ContentAddress(nil, canonicalContent, "sha1")))
+
+	o1 := contentObject{ID: "34a0163ffe38ca0978fdb0ba66d4f3965be80659", Body: "hello"}
+	o2 := contentObject{ID: "34a0163ffe38ca0978fdb0ba66d4f3965be80659", Body: "tampered"}
+	assert.Nil(t, rules.Validate(&o1))
+	assert.EqualError(t, rules.Validate(&o2), "ID: id does not match content hash.")
+	assert.Nil(t, rules.Validate(&o1))
+}
+
+func TestContentAddressRule_Error(t *testing.T) {
+	o := contentObject{ID: "abc"}
+	r := ContentAddress(&o.ID, canonicalContent, "md5").Error("tampered")
+	assert.EqualError(t, ValidateStruct(&o, Field(&o.ID, r)), "ID: tampered.")
+}
+
+func TestContentAddressRule_ErrorObject(t *testing.T) {
+	r := ContentAddress(nil, nil, "sha256")
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
@@ -0,0 +1,86 @@
+package validate
+
+import (
+	"errors"
+	"reflect"
+)
+
+var (
+	// ErrCountTooMany is the error that returns when a collection has too many items.
+	ErrCountTooMany = NewError("validation_count_too_many", "must contain no more than {{.max}} items")
+	// ErrCountTooFew is the error that returns when a collection has too few items.
+	ErrCountTooFew = NewError("validation_count_too_few", "must contain at least {{.min}} items")
+	// ErrCountInvalid is the error that returns when a collection does not have the exact number of items.
+	ErrCountInvalid = NewError("validation_count_invalid", "must contain exactly {{.min}} items")
+	// ErrCountOutOfRange is the error that returns when the number of items of a collection is out of range.
+	ErrCountOutOfRange = NewError("validation_count_out_of_range", "must contain between {{.min}} and {{.max}} items")
+)
+
+// errCountNotCollection is the error that a Count rule is used with a value that is not a collection.
+var errCountNotCollection = errors.New("Count can only be used with slices, arrays and maps")
+
+// Count returns a validation rule that checks if the number of items of a slice, array or map is within
+// the specified range. If max is 0, it means there is no upper bound for the number of items.
+// Unlike Length, its error messages refer to items; use Length for strings. Validating a value that is
+// not a slice, an array or a map results in an internal error.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func Count(min, max int) CountRule {
+	return CountRule{min: min, max: max, err: buildCountRuleError(min, max)}
+}
+
+// CountRule is a validation rule that checks if the number of items of a collection is within the specified range.
+type CountRule struct {
+	min, max int
+	err      Error
+}
+
+// Validate checks if the given value is valid or not.
+func (r CountRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil {
+		return nil
+	}
+
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+	default:
+		return NewInternalError(errCountNotCollection)
+	}
+
+	l := v.Len()
+	if l == 0 {
+		return nil
+	}
+	if l < r.min || r.max > 0 && l > r.max {
+		return r.err
+	}
+	return nil
+}
+
+// Error sets the error message for the rule.
+func (r CountRule) Error(message string) CountRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r CountRule) ErrorObject(err Error) CountRule {
+	r.err = err
+	return r
+}
+
+func buildCountRuleError(min, max int) (err Error) {
+	switch {
+	case max == 0:
+		err = ErrCountTooFew
+	case min == 0:
+		err = ErrCountTooMany
+	case min == max:
+		err = ErrCountInvalid
+	default:
+		err = ErrCountOutOfRange
+	}
+
+	return err.SetParams(map[string]interface{}{"min": min, "max": max})
+}
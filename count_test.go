@@ -0,0 +1,65 @@
+package validate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCount(t *testing.T) {
+	var nilSlice []int
+	var nilPtr *[]int
+	s := []int{1, 2, 3}
+	tests := []struct {
+		tag      string
+		min, max int
+		value    interface{}
+		err      string
+	}{
+		{"t1", 2, 4, []int{1, 2, 3}, ""},
+		{"t2", 2, 4, []int{1}, "must contain between 2 and 4 items"},
+		{"t3", 2, 4, []int{1, 2, 3, 4, 5}, "must contain between 2 and 4 items"},
+		{"t4", 2, 4, nilSlice, ""},
+		{"t5", 2, 4, []int{}, ""},
+		{"t6", 2, 4, nilPtr, ""},
+		{"t7", 2, 4, &s, ""},
+		{"t8", 2, 2, [2]string{"a", "b"}, ""},
+		{"t9", 3, 3, [2]string{"a", "b"}, "must contain exactly 3 items"},
+		{"t10", 0, 1, map[string]int{"a": 1, "b": 2}, "must contain no more than 1 items"},
+		{"t11", 0, 2, map[string]int{"a": 1, "b": 2}, ""},
+		{"t12", 3, 0, map[string]int{"a": 1, "b": 2}, "must contain at least 3 items"},
+		{"t13", 3, 0, []string{"a", "b", "c", "d", "e"}, ""},
+		{"t14", 0, 0, []string{"a", "b", "c"}, ""},
+		{"t15", 2, 4, "abc", "Count can only be used with slices, arrays and maps"},
+		{"t16", 2, 4, 123, "Count can only be used with slices, arrays and maps"},
+	}
+
+	for _, test := range tests {
+		err := Count(test.min, test.max).Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	for _, value := range []interface{}{"abc", "", 123} {
+		_, ok := Count(2, 4).Validate(value).(InternalError)
+		assert.True(t, ok, "%v", value)
+	}
+}
+
+func TestCountRule_Error(t *testing.T) {
+	r := Count(2, 0)
+	assert.Equal(t, "must contain at least 2 items", r.Validate([]int{1}).Error())
+	r = r.Error("123")
+	assert.Equal(t, "123", r.err.Message())
+	assert.Equal(t, ErrCountTooFew.Code(), r.err.Code())
+}
+
+func TestCountRule_ErrorObject(t *testing.T) {
+	r := Count(2, 0)
+
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
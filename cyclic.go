@@ -0,0 +1,90 @@
+package validate
+
+import (
+	"context"
+	"strconv"
+)
+
+// ErrCyclicLength is the error that returns when the length of a slice is not a multiple of the cycle length.
+var ErrCyclicLength = NewError("validation_cyclic_length", "must contain a multiple of {{.period}} items")
+
+// Cyclic returns a validation rule that validates each element of a slice or array with the rules
+// for its position within a repeating cycle: element i is validated with rulesByPosition[i % N],
+// where N is the number of rule lists. This is useful for flattened groups, e.g. a [key, value, key, value, ...]
+// slice can be validated with:
+//    validation.Cyclic([][]validation.Rule{
+//        {validation.Required, is.Alphanumeric},
+//        {validation.Length(0, 100)},
+//    })
+//
+// The length of the slice must be a multiple of N. Errors of the elements are keyed by their index.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func Cyclic(rulesByPosition [][]Rule) CyclicRule {
+	return CyclicRule{
+		rules: rulesByPosition,
+		err:   ErrCyclicLength,
+	}
+}
+
+// CyclicRule is a validation rule that validates slice elements with rules chosen by their position in a cycle.
+type CyclicRule struct {
+	rules [][]Rule
+	err   Error
+}
+
+// Validate checks if the given value is valid or not.
+func (r CyclicRule) Validate(value interface{}) error {
+	return r.ValidateWithContext(nil, value)
+}
+
+// ValidateWithContext checks if the given value is valid or not using the given context.
+func (r CyclicRule) ValidateWithContext(ctx context.Context, value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) || len(r.rules) == 0 {
+		return nil
+	}
+
+	v, err := sliceValue(value)
+	if err != nil {
+		return err
+	}
+
+	if v.Len()%len(r.rules) != 0 {
+		return r.err.SetParams(map[string]interface{}{"period": len(r.rules)})
+	}
+
+	errs := Errors{}
+	for i := 0; i < v.Len(); i++ {
+		val := v.Index(i).Interface()
+		rules := r.rules[i%len(r.rules)]
+		var err error
+		if ctx == nil {
+			err = Validate(val, rules...)
+		} else {
+			err = ValidateWithContext(ctx, val, rules...)
+		}
+		if err != nil {
+			if ie, ok := err.(InternalError); ok && ie.InternalError() != nil {
+				return err
+			}
+			errs[strconv.Itoa(i)] = err
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// Error sets the error message that is used when the length is not a multiple of the cycle length.
+func (r CyclicRule) Error(message string) CyclicRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct that is used when the length is not a multiple of the cycle length.
+func (r CyclicRule) ErrorObject(err Error) CyclicRule {
+	r.err = err
+	return r
+}
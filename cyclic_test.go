@@ -0,0 +1,76 @@
+package validate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCyclic(t *testing.T) {
+	var nilSlice []string
+
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", nilSlice, ""},
+		{"t2", []string{}, ""},
+		{"t3", []string{"a", "1", "b", ""}, ""},
+		{"t4", []string{"", "1", "b", "123"}, "0: cannot be blank; 3: the length must be no more than 2."},
+		{"t5", []string{"a", "1", "b"}, "must contain a multiple of 2 items"},
+		{"t6", [2]string{"a", "1"}, ""},
+		{"t7", &[]string{"", "1"}, "0: cannot be blank."},
+		{"t8", "ab", "must be a slice or an array"},
+	}
+
+	for _, test := range tests {
+		r := Cyclic([][]Rule{
+			{Required},
+			{Length(0, 2)},
+		})
+		err := r.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestCyclicRule_ValidateWithContext(t *testing.T) {
+	r := Cyclic([][]Rule{
+		{WithContext(func(ctx context.Context, value interface{}) error {
+			if ctx.Value(inContextKey{}) == value {
+				return nil
+			}
+			return NewError("", "unexpected value")
+		})},
+		{Required},
+	})
+	ctx := context.WithValue(context.Background(), inContextKey{}, "key")
+
+	assert.Nil(t, r.ValidateWithContext(ctx, []string{"key", "a", "key", "b"}))
+	assert.EqualError(t, r.ValidateWithContext(ctx, []string{"key", "a", "other", ""}), "2: unexpected value; 3: cannot be blank.")
+}
+
+func TestCyclicRule_InternalError(t *testing.T) {
+	r := Cyclic([][]Rule{{InContext(inContextKey{})}})
+	err := r.Validate([]string{"a"})
+	if assert.NotNil(t, err) {
+		_, ok := err.(InternalError)
+		assert.True(t, ok)
+	}
+}
+
+func TestCyclicRule_Error(t *testing.T) {
+	r := Cyclic([][]Rule{{}, {}}).Error("pairs expected, period {{.period}}")
+	assert.Equal(t, "pairs expected, period 2", r.Validate([]int{1}).Error())
+}
+
+func TestCyclicRule_ErrorObject(t *testing.T) {
+	r := Cyclic(nil)
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
@@ -0,0 +1,75 @@
+package validate
+
+import (
+	"errors"
+	"math"
+)
+
+// ErrDBInteger is the error that returns when an integer does not fit into a database column.
+var ErrDBInteger = NewError("validation_db_integer", "value is out of range for an {{.column}} column")
+
+// dbIntegerRanges maps PostgreSQL integer column types and their aliases to their value ranges.
+var dbIntegerRanges = map[string][2]int64{
+	"int2":     {math.MinInt16, math.MaxInt16},
+	"smallint": {math.MinInt16, math.MaxInt16},
+	"int4":     {math.MinInt32, math.MaxInt32},
+	"integer":  {math.MinInt32, math.MaxInt32},
+	"int8":     {math.MinInt64, math.MaxInt64},
+	"bigint":   {math.MinInt64, math.MaxInt64},
+}
+
+// DBInteger returns a validation rule that checks if an integer fits into a database column of the given type,
+// which prevents overflow errors at insert time. The supported column types are "smallint", "integer" and
+// "bigint", and their aliases "int2", "int4" and "int8". An unknown column type causes an internal error.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func DBInteger(column string) DBIntegerRule {
+	return DBIntegerRule{
+		column: column,
+		err:    ErrDBInteger,
+	}
+}
+
+// DBIntegerRule is a validation rule that checks if an integer fits into a database column.
+type DBIntegerRule struct {
+	column string
+	err    Error
+}
+
+// Validate checks if the given value is valid or not.
+func (r DBIntegerRule) Validate(value interface{}) error {
+	bounds, ok := dbIntegerRanges[r.column]
+	if !ok {
+		return NewInternalError(errors.New("unknown database integer type: " + r.column))
+	}
+
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	if v, err := ToInt(value); err == nil {
+		if v >= bounds[0] && v <= bounds[1] {
+			return nil
+		}
+	} else if u, uerr := ToUint(value); uerr == nil {
+		if u <= uint64(bounds[1]) {
+			return nil
+		}
+	} else {
+		return err
+	}
+
+	return r.err.SetParams(map[string]interface{}{"column": r.column})
+}
+
+// Error sets the error message for the rule.
+func (r DBIntegerRule) Error(message string) DBIntegerRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r DBIntegerRule) ErrorObject(err Error) DBIntegerRule {
+	r.err = err
+	return r
+}
@@ -0,0 +1,62 @@
+package validate
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDBInteger(t *testing.T) {
+	var nilPtr *int64
+	big := int64(math.MaxInt32 + 1)
+
+	tests := []struct {
+		tag    string
+		column string
+		value  interface{}
+		err    string
+	}{
+		{"t1", "int4", 0, ""},
+		{"t2", "int4", nilPtr, ""},
+		{"t3", "int4", math.MaxInt32, ""},
+		{"t4", "int4", math.MinInt32, ""},
+		{"t5", "int4", big, "value is out of range for an int4 column"},
+		{"t6", "int4", &big, "value is out of range for an int4 column"},
+		{"t7", "int4", int64(math.MinInt32 - 1), "value is out of range for an int4 column"},
+		{"t8", "integer", uint32(math.MaxUint32), "value is out of range for an integer column"},
+		{"t9", "smallint", int16(math.MinInt16), ""},
+		{"t10", "smallint", 40000, "value is out of range for an smallint column"},
+		{"t11", "int2", uint8(255), ""},
+		{"t12", "bigint", int64(math.MaxInt64), ""},
+		{"t13", "bigint", uint64(math.MaxInt64), ""},
+		{"t14", "int8", uint64(math.MaxInt64 + 1), "value is out of range for an int8 column"},
+		{"t15", "int4", "1", "cannot convert string to int64"},
+	}
+
+	for _, test := range tests {
+		err := DBInteger(test.column).Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	err := DBInteger("int16").Validate(1)
+	if assert.NotNil(t, err) {
+		_, ok := err.(InternalError)
+		assert.True(t, ok)
+	}
+}
+
+func TestDBIntegerRule_Error(t *testing.T) {
+	r := DBInteger("int2").Error("too large for {{.column}}")
+	assert.Equal(t, "too large for int2", r.Validate(1<<20).Error())
+}
+
+func TestDBIntegerRule_ErrorObject(t *testing.T) {
+	r := DBInteger("int2")
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
@@ -0,0 +1,81 @@
+package validate
+
+import (
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"strings"
+)
+
+// ErrDigestMismatch is the error that returns when the digest of a value does not match the expected one.
+var ErrDigestMismatch = NewError("validation_digest_mismatch", "content digest does not match")
+
+// digestAlgorithms lists the hash functions supported by DigestMatches, indexed by name.
+var digestAlgorithms = map[string]func() hash.Hash{
+	"md5":    md5.New,
+	"sha1":   sha1.New,
+	"sha256": sha256.New,
+}
+
+// DigestMatches returns a validation rule that checks if the digest of a string or byte slice equals
+// the expected hex-encoded digest. The algorithm is selected by name and can be "sha256", "sha1" or "md5".
+// The hex digest is compared case-insensitively.
+// If the algorithm is unknown, the rule returns an internal error.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func DigestMatches(algo string, expectedHex string) DigestRule {
+	return DigestRule{
+		algo:     strings.ToLower(algo),
+		expected: strings.ToLower(expectedHex),
+		err:      ErrDigestMismatch,
+	}
+}
+
+// DigestRule is a validation rule that checks if the digest of a value matches an expected digest.
+type DigestRule struct {
+	algo     string
+	expected string
+	err      Error
+}
+
+// Validate checks if the given value is valid or not.
+func (r DigestRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	str, err := EnsureString(value)
+	if err != nil {
+		return err
+	}
+
+	newHash, ok := digestAlgorithms[r.algo]
+	if !ok {
+		return NewInternalError(fmt.Errorf("unsupported digest algorithm %q", r.algo))
+	}
+
+	h := newHash()
+	h.Write([]byte(str))
+	actual := hex.EncodeToString(h.Sum(nil))
+
+	if subtle.ConstantTimeCompare([]byte(actual), []byte(r.expected)) == 1 {
+		return nil
+	}
+	return r.err
+}
+
+// Error sets the error message for the rule.
+func (r DigestRule) Error(message string) DigestRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r DigestRule) ErrorObject(err Error) DigestRule {
+	r.err = err
+	return r
+}
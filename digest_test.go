@@ -0,0 +1,60 @@
+package validate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDigestMatches(t *testing.T) {
+	const (
+		sha256Abc = "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad"
+		sha1Abc   = "a9993e364706816aba3e25717850c26c9cd0d89d"
+		md5Abc    = "900150983cd24fb0d6963f7d28e17f72"
+	)
+
+	tests := []struct {
+		tag      string
+		algo     string
+		expected string
+		value    interface{}
+		err      string
+	}{
+		{"t1", "sha256", sha256Abc, "", ""},
+		{"t2", "sha256", sha256Abc, "abc", ""},
+		{"t3", "SHA256", "BA7816BF8F01CFEA414140DE5DAE2223B00361A396177A9CB410FF61F20015AD", "abc", ""},
+		{"t4", "sha256", sha256Abc, "abd", "content digest does not match"},
+		{"t5", "sha1", sha1Abc, []byte("abc"), ""},
+		{"t6", "md5", md5Abc, "abc", ""},
+		{"t7", "md5", sha256Abc, "abc", "content digest does not match"},
+		{"t8", "sha256", sha256Abc, 123, "must be either a string or byte slice"},
+		{"t9", "crc32", "352441c2", "abc", "unsupported digest algorithm \"crc32\""},
+	}
+
+	for _, test := range tests {
+		r := DigestMatches(test.algo, test.expected)
+		err := r.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	_, ok := DigestMatches("crc32", "").Validate("abc").(InternalError)
+	assert.True(t, ok)
+}
+
+func TestDigestRule_Error(t *testing.T) {
+	r := DigestMatches("md5", "")
+	assert.Equal(t, "content digest does not match", r.Validate("abc").Error())
+
+	r = r.Error("123")
+	assert.Equal(t, "123", r.err.Message())
+}
+
+func TestDigestRule_ErrorObject(t *testing.T) {
+	r := DigestMatches("md5", "")
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
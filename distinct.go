@@ -0,0 +1,71 @@
+package validate
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// ErrDistinctBy is the error that returns when two slice elements have the same key.
+var ErrDistinctBy = NewError("validation_distinct_by", "duplicate key: {{.key}}")
+
+// DistinctBy returns a validation rule that checks if the elements of a slice or array are pairwise distinct
+// by the key returned by keyFn, e.g. to make sure a list of structs contains each ID only once:
+//    validation.DistinctBy(func(v interface{}) interface{} {
+//        return v.(Item).ID
+//    }).Error("duplicate id: {{.key}}")
+//
+// The first duplicate key is reported in the "key" parameter of the error.
+// The keys must be comparable; otherwise the rule returns an internal error.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func DistinctBy(keyFn func(interface{}) interface{}) DistinctByRule {
+	return DistinctByRule{
+		keyFn: keyFn,
+		err:   ErrDistinctBy,
+	}
+}
+
+// DistinctByRule is a validation rule that checks if the elements of a slice have distinct keys.
+type DistinctByRule struct {
+	keyFn func(interface{}) interface{}
+	err   Error
+}
+
+// Validate checks if the given value is valid or not.
+func (r DistinctByRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	v, err := sliceValue(value)
+	if err != nil {
+		return err
+	}
+
+	seen := map[interface{}]bool{}
+	for i := 0; i < v.Len(); i++ {
+		key := r.keyFn(v.Index(i).Interface())
+		if key != nil {
+			if key, err = comparableElem(reflect.ValueOf(key)); err != nil {
+				return err
+			}
+		}
+		if seen[key] {
+			return r.err.SetParams(map[string]interface{}{"key": fmt.Sprint(key)})
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// Error sets the error message for the rule.
+func (r DistinctByRule) Error(message string) DistinctByRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r DistinctByRule) ErrorObject(err Error) DistinctByRule {
+	r.err = err
+	return r
+}
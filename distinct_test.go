@@ -0,0 +1,68 @@
+package validate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type distinctItem struct {
+	ID   int
+	Name string
+}
+
+func TestDistinctBy(t *testing.T) {
+	byID := func(v interface{}) interface{} { return v.(distinctItem).ID }
+	var nilSlice []distinctItem
+
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", nilSlice, ""},
+		{"t2", []distinctItem{}, ""},
+		{"t3", []distinctItem{{1, "a"}, {2, "a"}, {3, "b"}}, ""},
+		{"t4", []distinctItem{{42, "a"}, {2, "b"}, {42, "c"}}, "duplicate key: 42"},
+		{"t5", &[]distinctItem{{1, "a"}, {1, "a"}}, "duplicate key: 1"},
+		{"t6", [2]distinctItem{{1, "a"}, {2, "a"}}, ""},
+		{"t7", distinctItem{1, "a"}, "must be a slice or an array"},
+	}
+
+	for _, test := range tests {
+		err := DistinctBy(byID).Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestDistinctBy_Keys(t *testing.T) {
+	identity := func(v interface{}) interface{} { return v }
+
+	assert.Nil(t, DistinctBy(identity).Validate([]interface{}{nil, 1, "1"}))
+	assert.EqualError(t, DistinctBy(identity).Validate([]interface{}{nil, nil}), "duplicate key: <nil>")
+
+	type box struct{ V interface{} }
+	for _, value := range []interface{}{[][]int{{1}}, []box{{[]int{1}}, {[]int{1}}}} {
+		err := DistinctBy(identity).Validate(value)
+		if assert.NotNil(t, err) {
+			_, ok := err.(InternalError)
+			assert.True(t, ok)
+		}
+	}
+}
+
+func TestDistinctByRule_Error(t *testing.T) {
+	byID := func(v interface{}) interface{} { return v.(distinctItem).ID }
+	r := DistinctBy(byID).Error("duplicate id: {{.key}}")
+	assert.Equal(t, "duplicate id: 42", r.Validate([]distinctItem{{42, "a"}, {42, "b"}}).Error())
+}
+
+func TestDistinctByRule_ErrorObject(t *testing.T) {
+	r := DistinctBy(nil)
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
@@ -7,6 +7,7 @@ package validate
 import (
 	"context"
 	"errors"
+	"fmt"
 	"reflect"
 	"strconv"
 )
@@ -95,3 +96,59 @@ func (r EachRule) getString(value reflect.Value) string {
 		return value.String()
 	}
 }
+
+// EachKey returns a validation rule that loops through the keys of a map and validates each key
+// with the provided rules. The errors are keyed by the string representation of the offending keys.
+// An empty map is considered valid, while values other than maps result in an internal error.
+// For example, to make sure all keys of a map[string]T are lowercase identifiers:
+//    validation.Field(&c.Labels, validation.EachKey(validation.Match(regexp.MustCompile("^[a-z_]+$"))))
+func EachKey(rules ...Rule) EachKeyRule {
+	return EachKeyRule{
+		rules: rules,
+	}
+}
+
+// EachKeyRule is a validation rule that validates the keys of a map using the specified list of rules.
+type EachKeyRule struct {
+	rules []Rule
+}
+
+// Validate loops through the keys of the given map and validates each of them.
+func (r EachKeyRule) Validate(value interface{}) error {
+	return r.ValidateWithContext(nil, value)
+}
+
+// ValidateWithContext loops through the keys of the given map and validates each of them with the given context.
+func (r EachKeyRule) ValidateWithContext(ctx context.Context, value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil {
+		return nil
+	}
+
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Map {
+		return NewInternalError(ErrNotMap)
+	}
+
+	errs := Errors{}
+	for _, k := range v.MapKeys() {
+		key := k.Interface()
+		var err error
+		if ctx == nil {
+			err = Validate(key, r.rules...)
+		} else {
+			err = ValidateWithContext(ctx, key, r.rules...)
+		}
+		if err != nil {
+			if ie, ok := err.(InternalError); ok && ie.InternalError() != nil {
+				return err
+			}
+			errs[fmt.Sprint(key)] = err
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
@@ -3,6 +3,7 @@ package validate
 import (
 	"context"
 	"errors"
+	"regexp"
 	"strings"
 	"testing"
 )
@@ -72,3 +73,54 @@ func TestEachWithContext(t *testing.T) {
 		assertError(t, test.err, err, test.tag)
 	}
 }
+
+func TestEachKey(t *testing.T) {
+	var nilMap map[string]int
+	var nilPtr *map[string]int
+	reKey := regexp.MustCompile("^[a-z_]+$")
+
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", nilMap, ""},
+		{"t2", nilPtr, ""},
+		{"t3", map[string]int{}, ""},
+		{"t4", map[string]int{"abc": 1, "a_b": 2}, ""},
+		{"t5", map[string]int{"abc": 1, "aBc": 2, "a-b": 3}, "a-b: must be in a valid format; aBc: must be in a valid format."},
+		{"t6", &map[string]string{"X": ""}, "X: must be in a valid format."},
+	}
+
+	for _, test := range tests {
+		err := EachKey(Match(reKey)).Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	err := EachKey(Min(1), Max(10)).Validate(map[int]string{-1: "a", 5: "b", 11: "c"})
+	assertError(t, "-1: must be no less than 1; 11: must be no greater than 10.", err, "int keys")
+	err = EachKey(Length(1, 3)).Validate(map[string]int{"": 1})
+	assertError(t, "", err, "empty key")
+
+	for _, value := range []interface{}{"abc", []string{"a"}, 1} {
+		err := EachKey(Required).Validate(value)
+		if _, ok := err.(InternalError); !ok {
+			t.Errorf("expected an internal error for %v, got %v", value, err)
+		}
+	}
+}
+
+func TestEachKeyWithContext(t *testing.T) {
+	rule := WithContext(func(ctx context.Context, value interface{}) error {
+		if !strings.HasPrefix(value.(string), ctx.Value(contains).(string)) {
+			return errors.New("unexpected key")
+		}
+		return nil
+	})
+	ctx := context.WithValue(context.Background(), contains, "x_")
+
+	err := EachKey(rule).ValidateWithContext(ctx, map[string]int{"x_a": 1, "y_b": 2})
+	assertError(t, "y_b: unexpected key.", err, "t1")
+	err = ValidateWithContext(ctx, map[string]int{"x_a": 1}, EachKey(rule))
+	assertError(t, "", err, "t2")
+}
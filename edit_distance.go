@@ -0,0 +1,118 @@
+package validate
+
+// ErrMinEditDistance is the error that returns when a string is too similar to a forbidden value.
+var ErrMinEditDistance = NewError("validation_min_edit_distance", "too similar to an existing value")
+
+// MinEditDistance returns a validation rule that checks if a string is at least min edits
+// (Levenshtein distance over runes) away from every forbidden value.
+// For example, with min set to 2, "admin" rejects "admin" and "admn" but accepts "adm".
+//
+// The distance computation stops as soon as it is known to reach min, so the cost per forbidden
+// value is bounded by O(len(value) * min) rather than growing with the product of both lengths.
+// This rule should only be used for validating strings and byte slices.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func MinEditDistance(forbidden []string, min int) EditDistanceRule {
+	return EditDistanceRule{
+		forbidden: forbidden,
+		min:       min,
+		err:       ErrMinEditDistance,
+	}
+}
+
+// EditDistanceRule is a validation rule that checks if a string is sufficiently different from forbidden values.
+type EditDistanceRule struct {
+	forbidden []string
+	min       int
+	err       Error
+}
+
+// Validate checks if the given value is valid or not.
+func (r EditDistanceRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	str, err := EnsureString(value)
+	if err != nil {
+		return err
+	}
+
+	s := []rune(str)
+	for _, f := range r.forbidden {
+		if editDistanceBelow(s, []rune(f), r.min) {
+			return r.err
+		}
+	}
+	return nil
+}
+
+// Error sets the error message for the rule.
+func (r EditDistanceRule) Error(message string) EditDistanceRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r EditDistanceRule) ErrorObject(err Error) EditDistanceRule {
+	r.err = err
+	return r
+}
+
+// editDistanceBelow reports whether the Levenshtein distance between a and b is less than limit.
+// Only the cells within limit of the diagonal are computed, and the computation stops as soon
+// as every cell of a row reaches limit.
+func editDistanceBelow(a, b []rune, limit int) bool {
+	if limit <= 0 {
+		return false
+	}
+	if d := len(a) - len(b); d >= limit || -d >= limit {
+		return false
+	}
+
+	// cells outside the band are treated as limit, which is enough to decide the comparison
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = minInt(j, limit)
+	}
+	for i := 1; i <= len(a); i++ {
+		lo, hi := maxInt(1, i-limit), minInt(len(b), i+limit)
+		cur[0] = minInt(i, limit)
+		if lo > 1 {
+			cur[lo-1] = limit
+		}
+		rowMin := cur[0]
+		for j := lo; j <= hi; j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			d := minInt(prev[j-1]+cost, minInt(prev[j]+1, cur[j-1]+1))
+			cur[j] = minInt(d, limit)
+			rowMin = minInt(rowMin, cur[j])
+		}
+		if hi < len(b) {
+			cur[hi+1] = limit
+		}
+		if rowMin >= limit {
+			return false
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)] < limit
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,90 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMinEditDistance(t *testing.T) {
+	forbidden := []string{"admin", "root"}
+
+	tests := []struct {
+		tag   string
+		min   int
+		value interface{}
+		err   string
+	}{
+		{"t1", 2, "", ""},
+		{"t2", 2, "admin", "too similar to an existing value"},
+		{"t3", 2, "admn", "too similar to an existing value"},
+		{"t4", 2, "admins", "too similar to an existing value"},
+		{"t5", 2, "adm", ""},
+		{"t6", 2, "rot", "too similar to an existing value"},
+		{"t7", 2, "alice", ""},
+		{"t8", 1, "admn", ""},
+		{"t9", 0, "admin", ""},
+		{"t10", 3, "ädmin", "too similar to an existing value"},
+		{"t11", 2, []byte("root"), "too similar to an existing value"},
+		{"t12", 2, 123, "must be either a string or byte slice"},
+		{"t13", 3, strings.Repeat("a", 10000), ""},
+	}
+
+	for _, test := range tests {
+		r := MinEditDistance(forbidden, test.min)
+		err := r.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func Test_editDistanceBelow(t *testing.T) {
+	words := []string{"", "a", "ab", "abc", "kitten", "sitting", "saturday", "sunday", "flaw", "lawn", "日本語", "日本"}
+	for _, a := range words {
+		for _, b := range words {
+			d := levenshtein([]rune(a), []rune(b))
+			for limit := 0; limit <= 8; limit++ {
+				assert.Equal(t, d < limit, editDistanceBelow([]rune(a), []rune(b), limit), "%q %q %d", a, b, limit)
+			}
+		}
+	}
+}
+
+func TestEditDistanceRule_Error(t *testing.T) {
+	r := MinEditDistance([]string{"abc"}, 1)
+	assert.Equal(t, "too similar to an existing value", r.Validate("abc").Error())
+
+	r = r.Error("123")
+	assert.Equal(t, "123", r.err.Message())
+}
+
+func TestEditDistanceRule_ErrorObject(t *testing.T) {
+	r := MinEditDistance(nil, 1)
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
+
+// levenshtein is an unbounded reference implementation of the edit distance.
+func levenshtein(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur := make([]int, len(b)+1)
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = minInt(prev[j-1]+cost, minInt(prev[j]+1, cur[j-1]+1))
+		}
+		prev = cur
+	}
+	return prev[len(b)]
+}
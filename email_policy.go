@@ -0,0 +1,104 @@
+package validate
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+var (
+	// ErrEmailPlusAddressing is the error that returns when an email address uses plus-addressing.
+	ErrEmailPlusAddressing = NewError("validation_email_plus_addressing", "email contains disallowed plus-addressing")
+	// ErrEmailDots is the error that returns when the local part of an email address contains dots.
+	ErrEmailDots = NewError("validation_email_dots", "email contains disallowed dots")
+	// ErrEmailLocalTooLong is the error that returns when the local part of an email address is too long.
+	ErrEmailLocalTooLong = NewError("validation_email_local_too_long", "email local part must be no more than {{.max}} characters")
+)
+
+// EmailPolicy returns a validation rule that enforces additional policies on the local part of an email address,
+// i.e. the part before the last "@". The policies are enabled with NoPlusAddressing, NoDots and MaxLocalLength:
+//    validation.Field(&u.Email, validation.Required, is.Email, validation.EmailPolicy().NoPlusAddressing().NoDots())
+//
+// The rule does not check the syntax of the address, so it should be combined with is.Email.
+// Values without an "@" are left to that rule and considered valid here.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func EmailPolicy() EmailPolicyRule {
+	return EmailPolicyRule{}
+}
+
+// EmailPolicyRule is a validation rule that enforces policies on the local part of an email address.
+type EmailPolicyRule struct {
+	noPlus, noDots bool
+	maxLocal       int
+	err            Error
+}
+
+// NoPlusAddressing disallows plus-addressing, i.e. a "+" in the local part such as "user+tag@example.com".
+func (r EmailPolicyRule) NoPlusAddressing() EmailPolicyRule {
+	r.noPlus = true
+	return r
+}
+
+// NoDots disallows dots in the local part, such as "first.last@example.com".
+func (r EmailPolicyRule) NoDots() EmailPolicyRule {
+	r.noDots = true
+	return r
+}
+
+// MaxLocalLength limits the local part to at most max characters.
+func (r EmailPolicyRule) MaxLocalLength(max int) EmailPolicyRule {
+	r.maxLocal = max
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r EmailPolicyRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	str, err := EnsureString(value)
+	if err != nil {
+		return err
+	}
+
+	at := strings.LastIndexByte(str, '@')
+	if at < 0 {
+		return nil
+	}
+	local := str[:at]
+
+	switch {
+	case r.noPlus && strings.Contains(local, "+"):
+		return r.error(ErrEmailPlusAddressing)
+	case r.noDots && strings.Contains(local, "."):
+		return r.error(ErrEmailDots)
+	case r.maxLocal > 0 && utf8.RuneCountInString(local) > r.maxLocal:
+		return r.error(ErrEmailLocalTooLong.SetParams(map[string]interface{}{"max": r.maxLocal}))
+	}
+	return nil
+}
+
+// error returns the custom error of the rule, if set, in place of the given policy error.
+func (r EmailPolicyRule) error(err Error) Error {
+	if r.err != nil {
+		return r.err.SetParams(err.Params())
+	}
+	return err
+}
+
+// Error sets the error message that is used for all policy violations in place of the specific ones.
+func (r EmailPolicyRule) Error(message string) EmailPolicyRule {
+	if r.err == nil {
+		r.err = NewError("validation_email_policy", message)
+	} else {
+		r.err = r.err.SetMessage(message)
+	}
+	return r
+}
+
+// ErrorObject sets the error struct that is used for all policy violations in place of the specific ones.
+func (r EmailPolicyRule) ErrorObject(err Error) EmailPolicyRule {
+	r.err = err
+	return r
+}
@@ -0,0 +1,55 @@
+package validate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEmailPolicy(t *testing.T) {
+	tests := []struct {
+		tag   string
+		rule  EmailPolicyRule
+		value interface{}
+		err   string
+	}{
+		{"t1", EmailPolicy(), "first.last+tag@example.com", ""},
+		{"t2", EmailPolicy().NoPlusAddressing(), "", ""},
+		{"t3", EmailPolicy().NoPlusAddressing(), "user@example.com", ""},
+		{"t4", EmailPolicy().NoPlusAddressing(), "user+tag@example.com", "email contains disallowed plus-addressing"},
+		{"t5", EmailPolicy().NoPlusAddressing(), "user@ex+ample.com", ""},
+		{"t6", EmailPolicy().NoDots(), "first.last@example.com", "email contains disallowed dots"},
+		{"t7", EmailPolicy().NoDots(), "firstlast@example.com", ""},
+		{"t8", EmailPolicy().MaxLocalLength(5), "abcde@example.com", ""},
+		{"t9", EmailPolicy().MaxLocalLength(5), "abcdef@example.com", "email local part must be no more than 5 characters"},
+		{"t10", EmailPolicy().NoPlusAddressing().NoDots(), "a.b+c@example.com", "email contains disallowed plus-addressing"},
+		{"t11", EmailPolicy().NoPlusAddressing(), "user+tag", ""},
+		{"t12", EmailPolicy().NoPlusAddressing(), 123, "must be either a string or byte slice"},
+	}
+
+	for _, test := range tests {
+		err := test.rule.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestEmailPolicyRule_Error(t *testing.T) {
+	r := EmailPolicy().NoDots().Error("not allowed by policy")
+	assert.Equal(t, "not allowed by policy", r.Validate("a.b@example.com").Error())
+
+	r = EmailPolicy().MaxLocalLength(3).Error("at most {{.max}} characters before the @")
+	assert.Equal(t, "at most 3 characters before the @", r.Validate("abcd@example.com").Error())
+
+	r = r.Error("123")
+	assert.Equal(t, "123", r.err.Message())
+}
+
+func TestEmailPolicyRule_ErrorObject(t *testing.T) {
+	r := EmailPolicy()
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
@@ -0,0 +1,71 @@
+package validate
+
+import (
+	"fmt"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/ianaindex"
+)
+
+// ErrNotEncodable is the error that returns when a string cannot be represented in a charset.
+var ErrNotEncodable = NewError("validation_not_encodable", "contains characters not representable in {{.charset}}")
+
+// Encodable returns a validation rule that checks if a string can be encoded in the given charset without loss.
+// The charset is looked up by its IANA name or alias, e.g. "ISO-8859-1", "latin1" or "windows-1252".
+// If the charset is unknown, the rule returns an internal error.
+// This rule should only be used for validating strings and byte slices.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func Encodable(charset string) EncodableRule {
+	enc, err := ianaindex.IANA.Encoding(charset)
+	if err == nil && enc == nil {
+		err = fmt.Errorf("charset %q is not supported", charset)
+	}
+	return EncodableRule{
+		charset: charset,
+		enc:     enc,
+		encErr:  err,
+		err:     ErrNotEncodable,
+	}
+}
+
+// EncodableRule is a validation rule that checks if a string can be encoded in a charset.
+type EncodableRule struct {
+	charset string
+	enc     encoding.Encoding
+	encErr  error
+	err     Error
+}
+
+// Validate checks if the given value is valid or not.
+func (r EncodableRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	str, err := EnsureString(value)
+	if err != nil {
+		return err
+	}
+
+	if r.encErr != nil {
+		return NewInternalError(r.encErr)
+	}
+
+	if _, err := r.enc.NewEncoder().String(str); err != nil {
+		return r.err.SetParams(map[string]interface{}{"charset": r.charset})
+	}
+	return nil
+}
+
+// Error sets the error message for the rule.
+func (r EncodableRule) Error(message string) EncodableRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r EncodableRule) ErrorObject(err Error) EncodableRule {
+	r.err = err
+	return r
+}
@@ -0,0 +1,53 @@
+package validate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncodable(t *testing.T) {
+	tests := []struct {
+		tag     string
+		charset string
+		value   interface{}
+		err     string
+	}{
+		{"t1", "ISO-8859-1", "", ""},
+		{"t2", "ISO-8859-1", "abc", ""},
+		{"t3", "ISO-8859-1", "café", ""},
+		{"t4", "ISO-8859-1", "€100", "contains characters not representable in ISO-8859-1"},
+		{"t5", "windows-1252", "€100", ""},
+		{"t6", "windows-1252", "日本", "contains characters not representable in windows-1252"},
+		{"t7", "latin1", []byte("café"), ""},
+		{"t8", "ISO-8859-1", 123, "must be either a string or byte slice"},
+		{"t9", "no-such-charset", "abc", "ianaindex: invalid encoding name"},
+	}
+
+	for _, test := range tests {
+		r := Encodable(test.charset)
+		err := r.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	_, ok := Encodable("no-such-charset").Validate("abc").(InternalError)
+	assert.True(t, ok)
+}
+
+func TestEncodableRule_Error(t *testing.T) {
+	r := Encodable("ISO-8859-1")
+	assert.Equal(t, "contains characters not representable in ISO-8859-1", r.Validate("€").Error())
+
+	r = r.Error("must be {{.charset}}")
+	assert.Equal(t, "must be ISO-8859-1", r.Validate("€").Error())
+}
+
+func TestEncodableRule_ErrorObject(t *testing.T) {
+	r := Encodable("ISO-8859-1")
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
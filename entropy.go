@@ -0,0 +1,72 @@
+package validate
+
+import "math"
+
+// ErrLowEntropy is the error that returns when a string is not random enough.
+var ErrLowEntropy = NewError("validation_low_entropy", "value is not random enough")
+
+// MinShannonEntropy returns a validation rule that checks if the Shannon entropy of a string, computed from
+// the frequencies of its characters, is at least the given number of bits per character. For example,
+// "aaaaaaaa" has an entropy of 0 and "abababab" of 1, while a random hex string approaches 4 and a random
+// base64 string 6 bits per character. Note that the entropy of short strings is bounded by the logarithm
+// of their length, so the threshold should be chosen together with a minimum length.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func MinShannonEntropy(bitsPerChar float64) EntropyRule {
+	return EntropyRule{
+		min: bitsPerChar,
+		err: ErrLowEntropy,
+	}
+}
+
+// EntropyRule is a validation rule that checks the Shannon entropy of a string.
+type EntropyRule struct {
+	min float64
+	err Error
+}
+
+// Validate checks if the given value is valid or not.
+func (r EntropyRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	str, err := EnsureString(value)
+	if err != nil {
+		return err
+	}
+
+	if shannonEntropy(str) < r.min {
+		return r.err.SetParams(map[string]interface{}{"min": r.min})
+	}
+	return nil
+}
+
+// shannonEntropy returns the Shannon entropy of a string in bits per character.
+func shannonEntropy(str string) float64 {
+	counts := map[rune]int{}
+	total := 0
+	for _, c := range str {
+		counts[c]++
+		total++
+	}
+
+	var entropy float64
+	for _, n := range counts {
+		p := float64(n) / float64(total)
+		entropy -= p * math.Log2(p)
+	}
+	return entropy
+}
+
+// Error sets the error message for the rule.
+func (r EntropyRule) Error(message string) EntropyRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r EntropyRule) ErrorObject(err Error) EntropyRule {
+	r.err = err
+	return r
+}
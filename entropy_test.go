@@ -0,0 +1,51 @@
+package validate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMinShannonEntropy(t *testing.T) {
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", "", ""},
+		{"t2", "aaaaaaaa", "value is not random enough"},
+		{"t3", "abababab", "value is not random enough"},
+		{"t4", "abcdabcd", ""},
+		{"t5", "9f86d081884c7d659a2feaa0c55ad015", ""},
+		{"t6", "ääääbbbb", "value is not random enough"},
+		{"t7", []byte("abcdefgh"), ""},
+		{"t8", 12345678, "must be either a string or byte slice"},
+	}
+
+	for _, test := range tests {
+		err := MinShannonEntropy(2).Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestShannonEntropy(t *testing.T) {
+	assert.Equal(t, 0.0, shannonEntropy("aaaa"))
+	assert.Equal(t, 1.0, shannonEntropy("abab"))
+	assert.Equal(t, 2.0, shannonEntropy("abcd"))
+	assert.Equal(t, 1.0, shannonEntropy("ääbb"))
+}
+
+func TestEntropyRule_Error(t *testing.T) {
+	r := MinShannonEntropy(3).Error("needs {{.min}} bits per character")
+	assert.Equal(t, "needs 3 bits per character", r.Validate("aaaa").Error())
+}
+
+func TestEntropyRule_ErrorObject(t *testing.T) {
+	r := MinShannonEntropy(3)
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
@@ -0,0 +1,79 @@
+package validate
+
+import (
+	"fmt"
+	"strings"
+)
+
+var (
+	// ErrEnumIndex is the error that returns when an integer is not a valid enum index.
+	ErrEnumIndex = NewError("validation_enum_index", "must be between 0 and {{.max}}")
+	// ErrEnumIndexNamed is the error that returns when an integer is not a valid index of a named enum.
+	ErrEnumIndexNamed = NewError("validation_enum_index_named", "must be one of: {{.names}}")
+)
+
+// EnumIndex returns a validation rule that checks if an integer is a valid index into an enum of n values,
+// i.e. 0 <= value < n. Call Names to label the values in the error message.
+// Unlike most rules, a zero value is not considered empty because 0 is a valid enum index.
+// A nil pointer is considered valid. Use the Required or NotNil rule to make sure a value is present.
+func EnumIndex(n int) EnumIndexRule {
+	return EnumIndexRule{
+		n:   n,
+		err: ErrEnumIndex,
+	}
+}
+
+// EnumIndexRule is a validation rule that checks if an integer is a valid enum index.
+type EnumIndexRule struct {
+	n     int
+	names []string
+	err   Error
+}
+
+// Names sets the labels of the enum values, which are listed in the error message along with their indexes,
+// e.g. "must be one of: small(0), medium(1), large(2)".
+func (r EnumIndexRule) Names(names ...string) EnumIndexRule {
+	r.names = names
+	if r.err.Code() == ErrEnumIndex.Code() && r.err.Message() == ErrEnumIndex.Message() {
+		r.err = ErrEnumIndexNamed
+	}
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r EnumIndexRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil {
+		return nil
+	}
+
+	index, err := toInt64(value)
+	if err != nil && err != errIntOverflow {
+		return err
+	}
+
+	if err == nil && index >= 0 && index < int64(r.n) {
+		return nil
+	}
+
+	labels := make([]string, len(r.names))
+	for i, name := range r.names {
+		labels[i] = fmt.Sprintf("%v(%v)", name, i)
+	}
+	return r.err.SetParams(map[string]interface{}{
+		"max":   r.n - 1,
+		"names": strings.Join(labels, ", "),
+	})
+}
+
+// Error sets the error message for the rule.
+func (r EnumIndexRule) Error(message string) EnumIndexRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r EnumIndexRule) ErrorObject(err Error) EnumIndexRule {
+	r.err = err
+	return r
+}
@@ -0,0 +1,60 @@
+package validate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnumIndex(t *testing.T) {
+	var nilPtr *int
+	one := 1
+
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", 0, ""},
+		{"t2", 2, ""},
+		{"t3", 3, "must be between 0 and 2"},
+		{"t4", -1, "must be between 0 and 2"},
+		{"t5", uint8(1), ""},
+		{"t6", uint64(1 << 63), "must be between 0 and 2"},
+		{"t7", &one, ""},
+		{"t8", nilPtr, ""},
+		{"t9", "1", "cannot convert string to int64"},
+	}
+
+	for _, test := range tests {
+		err := EnumIndex(3).Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestEnumIndexRule_Names(t *testing.T) {
+	r := EnumIndex(3).Names("small", "medium", "large")
+	assert.Nil(t, r.Validate(1))
+	assert.EqualError(t, r.Validate(3), "must be one of: small(0), medium(1), large(2)")
+
+	r = EnumIndex(3).Error("invalid size").Names("small", "medium", "large")
+	assert.EqualError(t, r.Validate(3), "invalid size")
+}
+
+func TestEnumIndexRule_Error(t *testing.T) {
+	r := EnumIndex(2)
+	assert.Equal(t, "must be between 0 and 1", r.Validate(2).Error())
+
+	r = r.Error("123")
+	assert.Equal(t, "123", r.err.Message())
+}
+
+func TestEnumIndexRule_ErrorObject(t *testing.T) {
+	r := EnumIndex(2)
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
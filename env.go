@@ -0,0 +1,63 @@
+package validate
+
+import "os"
+
+// ErrEnvVarNotSet is the error that returns when a value names an environment variable that is not set.
+var ErrEnvVarNotSet = NewError("validation_env_var_not_set", "environment variable is not set")
+
+// EnvVarExists returns a validation rule that checks if a string is the name of an environment variable
+// that is set in the current process. Note that the rule reads the process environment at validation time
+// via os.LookupEnv, so its result depends on the environment the program runs in.
+// A variable that is set to an empty string is considered to exist.
+// Use Value to look up the value of the variable, e.g. for substitution.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func EnvVarExists() EnvVarRule {
+	return EnvVarRule{
+		err: ErrEnvVarNotSet,
+	}
+}
+
+// EnvVarRule is a validation rule that checks if an environment variable is set.
+type EnvVarRule struct {
+	err Error
+}
+
+// Validate checks if the given value is valid or not.
+func (r EnvVarRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	str, err := EnsureString(value)
+	if err != nil {
+		return err
+	}
+
+	if _, err := r.Value(str); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Value returns the value of the environment variable with the given name.
+// An error is returned if the variable is not set.
+func (r EnvVarRule) Value(name string) (string, error) {
+	v, ok := os.LookupEnv(name)
+	if !ok {
+		return "", r.err
+	}
+	return v, nil
+}
+
+// Error sets the error message for the rule.
+func (r EnvVarRule) Error(message string) EnvVarRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r EnvVarRule) ErrorObject(err Error) EnvVarRule {
+	r.err = err
+	return r
+}
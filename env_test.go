@@ -0,0 +1,66 @@
+package validate
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnvVarExists(t *testing.T) {
+	os.Setenv("VALIDATE_TEST_SET", "abc")
+	os.Setenv("VALIDATE_TEST_EMPTY", "")
+	os.Unsetenv("VALIDATE_TEST_UNSET")
+	defer os.Unsetenv("VALIDATE_TEST_SET")
+	defer os.Unsetenv("VALIDATE_TEST_EMPTY")
+
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", "", ""},
+		{"t2", "VALIDATE_TEST_SET", ""},
+		{"t3", "VALIDATE_TEST_EMPTY", ""},
+		{"t4", "VALIDATE_TEST_UNSET", "environment variable is not set"},
+		{"t5", []byte("VALIDATE_TEST_SET"), ""},
+		{"t6", 123, "must be either a string or byte slice"},
+	}
+
+	for _, test := range tests {
+		err := EnvVarExists().Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestEnvVarRule_Value(t *testing.T) {
+	os.Setenv("VALIDATE_TEST_SET", "abc")
+	os.Unsetenv("VALIDATE_TEST_UNSET")
+	defer os.Unsetenv("VALIDATE_TEST_SET")
+
+	v, err := EnvVarExists().Value("VALIDATE_TEST_SET")
+	assert.Nil(t, err)
+	assert.Equal(t, "abc", v)
+
+	v, err = EnvVarExists().Value("VALIDATE_TEST_UNSET")
+	assert.EqualError(t, err, "environment variable is not set")
+	assert.Equal(t, "", v)
+}
+
+func TestEnvVarRule_Error(t *testing.T) {
+	os.Unsetenv("VALIDATE_TEST_UNSET")
+
+	r := EnvVarExists().Error("123")
+	assert.Equal(t, "123", r.err.Message())
+	assert.Equal(t, "123", r.Validate("VALIDATE_TEST_UNSET").Error())
+}
+
+func TestEnvVarRule_ErrorObject(t *testing.T) {
+	r := EnvVarExists()
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
@@ -0,0 +1,68 @@
+package validate
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// ErrNotEqual is the error that returns when a value does not equal the expected one.
+var ErrNotEqual = NewError("validation_not_equal", "must be equal to {{.expected}}")
+
+// Equal returns a validation rule that checks if a value equals the expected value.
+// reflect.DeepEqual() is used to compare the values, so a value of a different type
+// than the expected one is always invalid.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func Equal(expected interface{}) EqualRule {
+	return EqualRule{
+		expected: expected,
+		err:      ErrNotEqual.SetParams(map[string]interface{}{"expected": formatExpected(expected)}),
+	}
+}
+
+// EqualRule is a validation rule that validates if a value equals the expected value.
+type EqualRule struct {
+	expected interface{}
+	err      Error
+}
+
+// Validate checks if the given value is valid or not.
+func (r EqualRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	if reflect.DeepEqual(r.expected, value) {
+		return nil
+	}
+
+	return r.err
+}
+
+// Error sets the error message for the rule.
+func (r EqualRule) Error(message string) EqualRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r EqualRule) ErrorObject(err Error) EqualRule {
+	r.err = err
+	return r
+}
+
+// formatExpected returns a representation of the expected value suitable for error messages.
+func formatExpected(expected interface{}) interface{} {
+	switch expected.(type) {
+	case nil:
+		// the template engine renders nil parameters as "<no value>"
+		return "<nil>"
+	case fmt.Stringer, error:
+		return formatThreshold(expected)
+	}
+	if v, _ := Indirect(expected); v != nil && reflect.TypeOf(v).Kind() == reflect.Struct {
+		// include the field names so that "{1 2}" reads as "{X:1 Y:2}"
+		return fmt.Sprintf("%+v", v)
+	}
+	return formatThreshold(expected)
+}
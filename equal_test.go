@@ -0,0 +1,63 @@
+package validate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEqual(t *testing.T) {
+	type point struct {
+		X, Y int
+	}
+	s := "abc"
+	var sNil *string
+	tests := []struct {
+		tag      string
+		expected interface{}
+		value    interface{}
+		err      string
+	}{
+		{"t1", "abc", "abc", ""},
+		{"t2", "abc", "abd", "must be equal to abc"},
+		{"t3", "abc", "", ""},
+		{"t4", "abc", &s, ""},
+		{"t5", "abc", sNil, ""},
+		{"t6", 42, 42, ""},
+		{"t7", 42, 43, "must be equal to 42"},
+		{"t8", 42, int64(42), "must be equal to 42"},
+		{"t9", 42, "42", "must be equal to 42"},
+		{"t10", point{1, 2}, point{1, 2}, ""},
+		{"t11", point{1, 2}, point{2, 1}, "must be equal to {X:1 Y:2}"},
+		{"t12", point{1, 2}, &point{1, 2}, ""},
+		{"t13", point{1, 2}, struct{ X, Y int }{1, 2}, "must be equal to {X:1 Y:2}"},
+		{"t14", []int{1, 2}, []int{1, 2}, ""},
+		{"t15", []int{1, 2}, []int{2, 1}, "must be equal to [1 2]"},
+		{"t16", time.Second, 2 * time.Second, "must be equal to 1s"},
+		{"t17", nil, "abc", "must be equal to <nil>"},
+	}
+
+	for _, test := range tests {
+		err := Equal(test.expected).Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestEqualRule_Error(t *testing.T) {
+	r := Equal("abc")
+	assert.Equal(t, "must be equal to abc", r.Validate("xyz").Error())
+	r = r.Error("123")
+	assert.Equal(t, "123", r.err.Message())
+}
+
+func TestEqualRule_ErrorObject(t *testing.T) {
+	r := Equal("abc")
+
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
@@ -30,12 +30,17 @@ type (
 		code    string
 		message string
 		params  map[string]interface{}
+		value   interface{}
 	}
 
 	// Errors represents the validation errors that are indexed by struct field names, map or slice keys.
 	// values are Error or Errors (for map, slice and array error value is Errors).
 	Errors map[string]error
 
+	// FieldErrors represents the errors of all failing rules of a single value, in the order of the rules.
+	// It is reported for the fields specified with FieldAll.
+	FieldErrors []error
+
 	// InternalError represents an error that should NOT be treated as a validation error.
 	InternalError interface {
 		error
@@ -45,8 +50,23 @@ type (
 	internalError struct {
 		error
 	}
+
+	// Translator translates the messages of validation errors, e.g. to localize them.
+	Translator interface {
+		// Translate returns the message for the given error code and params, or an empty string
+		// to keep the original message. The message may refer to the params like the built-in messages do.
+		Translate(code string, params map[string]interface{}) string
+	}
+
+	// TranslatorFunc is an adapter to allow the use of ordinary functions as Translators.
+	TranslatorFunc func(code string, params map[string]interface{}) string
 )
 
+// Translate calls f(code, params).
+func (f TranslatorFunc) Translate(code string, params map[string]interface{}) string {
+	return f(code, params)
+}
+
 // NewInternalError wraps a given error into an InternalError.
 func NewInternalError(err error) InternalError {
 	return internalError{error: err}
@@ -89,6 +109,18 @@ func (e ErrorObject) Params() map[string]interface{} {
 	return e.params
 }
 
+// SetValue set the value that failed validation.
+func (e ErrorObject) SetValue(value interface{}) Error {
+	e.value = value
+	return e
+}
+
+// Value returns the value that failed validation. It is set by Validate and ValidateStruct
+// unless the value is marked with the Sensitive rule.
+func (e ErrorObject) Value() interface{} {
+	return e.value
+}
+
 // SetMessage set the error's message.
 func (e ErrorObject) SetMessage(message string) Error {
 	e.message = message
@@ -100,6 +132,14 @@ func (e ErrorObject) Message() string {
 	return e.message
 }
 
+// Localize returns a copy of the error whose message is translated by the given Translator.
+func (e ErrorObject) Localize(t Translator) Error {
+	if message := t.Translate(e.code, e.params); message != "" {
+		e.message = message
+	}
+	return e
+}
+
 // Error returns the error message.
 func (e ErrorObject) Error() string {
 	if len(e.params) == 0 {
@@ -113,6 +153,7 @@ func (e ErrorObject) Error() string {
 }
 
 // Error returns the error string of Errors.
+// The errors are ordered by their keys in lexical byte order, so the result is deterministic.
 func (es Errors) Error() string {
 	if len(es) == 0 {
 		return ""
@@ -142,18 +183,95 @@ func (es Errors) Error() string {
 }
 
 // MarshalJSON converts the Errors into a valid JSON.
+// Nested Errors are converted into nested objects, validation errors into objects holding their code and
+// message, e.g. {"code":"validation_required","message":"cannot be blank"}, and other errors into their
+// messages. Nil errors are omitted, so the result does not depend on whether Filter has been called.
+// Like for Error, the keys are ordered lexically, which encoding/json guarantees for maps.
 func (es Errors) MarshalJSON() ([]byte, error) {
 	errs := map[string]interface{}{}
 	for key, err := range es {
-		if ms, ok := err.(json.Marshaler); ok {
-			errs[key] = ms
+		if err != nil {
+			errs[key] = toJSON(err)
+		}
+	}
+	return json.Marshal(errs)
+}
+
+// toJSON returns the value representing the given non-nil error in JSON.
+func toJSON(err error) interface{} {
+	switch e := err.(type) {
+	case json.Marshaler:
+		return e
+	case Error:
+		return errorJSON{Code: e.Code(), Message: e.Error()}
+	default:
+		return err.Error()
+	}
+}
+
+// errorJSON is the JSON representation of a validation error.
+type errorJSON struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// Localize returns a copy of Errors whose messages are translated by the given Translator.
+// Nested Errors are localized recursively, and errors other than ErrorObject are kept as is.
+// The original Errors and rule messages are not modified.
+func (es Errors) Localize(t Translator) Errors {
+	res := make(Errors, len(es))
+	for key, err := range es {
+		switch e := err.(type) {
+		case Errors:
+			res[key] = e.Localize(t)
+		case FieldErrors:
+			res[key] = e.Localize(t)
+		case ErrorObject:
+			res[key] = e.Localize(t)
+		default:
+			res[key] = err
+		}
+	}
+	return res
+}
+
+// Error returns the error string of FieldErrors, which joins the messages of the errors with commas.
+// Nested Errors are enclosed in parentheses.
+func (es FieldErrors) Error() string {
+	s := make([]string, len(es))
+	for i, err := range es {
+		if errs, ok := err.(Errors); ok {
+			s[i] = fmt.Sprintf("(%v)", errs)
 		} else {
-			errs[key] = err.Error()
+			s[i] = err.Error()
 		}
 	}
+	return strings.Join(s, ", ")
+}
+
+// MarshalJSON converts the FieldErrors into a JSON array. The errors are converted the same way as by Errors.MarshalJSON.
+func (es FieldErrors) MarshalJSON() ([]byte, error) {
+	errs := make([]interface{}, len(es))
+	for i, err := range es {
+		errs[i] = toJSON(err)
+	}
 	return json.Marshal(errs)
 }
 
+// Localize returns a copy of FieldErrors whose messages are translated by the given Translator.
+// Errors other than ErrorObject are kept as is.
+func (es FieldErrors) Localize(t Translator) FieldErrors {
+	res := make(FieldErrors, len(es))
+	for i, err := range es {
+		if e, ok := err.(ErrorObject); ok {
+			res[i] = e.Localize(t)
+		} else {
+			res[i] = err
+		}
+	}
+	return res
+}
+
 // Filter removes all nils from Errors and returns back the updated Errors as an error.
 // If the length of Errors becomes 0, it will return nil.
 func (es Errors) Filter() error {
@@ -5,7 +5,10 @@
 package validate
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -47,6 +50,62 @@ func TestErrors_MarshalMessage(t *testing.T) {
 	assert.Equal(t, "{\"A\":\"A1\",\"B\":{\"2\":\"B1\"}}", string(errsJSON))
 }
 
+func TestErrors_MarshalJSON(t *testing.T) {
+	type Address struct {
+		Street string `json:"street"`
+		Zip    string `json:"zip"`
+	}
+	c := struct {
+		Name    string  `json:"name"`
+		Email   string  `json:"email"`
+		Address Address `json:"address"`
+	}{Email: "x"}
+	err := ValidateStruct(&c,
+		Field(&c.Name, Required),
+		Field(&c.Email, Length(5, 0)),
+		Field(&c.Address, By(func(interface{}) error {
+			return ValidateStruct(&c.Address,
+				Field(&c.Address.Street, Required),
+				Field(&c.Address.Zip, Required.Error("zip is missing")),
+			)
+		})),
+	)
+	errsJSON, jerr := json.Marshal(err)
+	assert.Nil(t, jerr)
+	assert.Equal(t, `{"address":{"street":{"code":"validation_required","message":"cannot be blank"},`+
+		`"zip":{"code":"validation_required","message":"zip is missing"}},`+
+		`"email":{"code":"validation_length_too_short","message":"the length must be no less than 5"},`+
+		`"name":{"code":"validation_required","message":"cannot be blank"}}`, string(errsJSON))
+
+	// nil errors are omitted whether or not the errors have been filtered
+	errs := Errors{"A": nil, "B": Errors{"C": nil, "D": errors.New("D1")}}
+	errsJSON, jerr = json.Marshal(errs)
+	assert.Nil(t, jerr)
+	assert.Equal(t, `{"B":{"D":"D1"}}`, string(errsJSON))
+}
+
+func TestErrors_Order(t *testing.T) {
+	errs := Errors{}
+	for i := 0; i < 50; i++ {
+		errs[fmt.Sprintf("k%02d", 49-i)] = Errors{fmt.Sprintf("n%d", i%7): ErrRequired, "a": errors.New("abc")}
+	}
+	errs["B"] = ErrRequired
+	errs["a"] = FieldErrors{ErrRequired, errors.New("abc")}
+
+	s := errs.Error()
+	assert.True(t, strings.HasPrefix(s, "B: cannot be blank; a: cannot be blank, abc; k00: (a: abc; n0: cannot be blank.); k01: (a: abc; n6: cannot be blank.)"), s)
+	b, err := json.Marshal(errs)
+	assert.Nil(t, err)
+	assert.True(t, strings.HasPrefix(string(b), `{"B":{"code":"validation_required","message":"cannot be blank"},"a":[`), string(b))
+
+	for i := 0; i < 20; i++ {
+		assert.Equal(t, s, errs.Error())
+		b2, err := json.Marshal(errs)
+		assert.Nil(t, err)
+		assert.Equal(t, string(b), string(b2))
+	}
+}
+
 func TestErrors_Filter(t *testing.T) {
 	errs := Errors{
 		"B": errors.New("B1"),
@@ -137,6 +196,86 @@ func TestErrorObject_AddParam(t *testing.T) {
 	assert.Equal(t, err.Params(), p)
 }
 
+func TestErrorObject_Value(t *testing.T) {
+	err := NewError("code", "A").(ErrorObject)
+	assert.Nil(t, err.Value())
+
+	err = err.SetValue(42).(ErrorObject)
+	assert.Equal(t, 42, err.value)
+	assert.Equal(t, 42, err.Value())
+}
+
+func TestErrorObject_Localize(t *testing.T) {
+	tr := TranslatorFunc(func(code string, params map[string]interface{}) string {
+		if code == "validation_length_out_of_range" {
+			return "die Länge muss zwischen {{.min}} und {{.max}} liegen"
+		}
+		return ""
+	})
+
+	err := ErrLengthOutOfRange.SetParams(map[string]interface{}{"min": 1, "max": 5}).(ErrorObject)
+	assert.Equal(t, "die Länge muss zwischen 1 und 5 liegen", err.Localize(tr).Error())
+	assert.Equal(t, "the length must be between 1 and 5", err.Error())
+	assert.Equal(t, "cannot be blank", ErrRequired.(ErrorObject).Localize(tr).Error())
+}
+
+func TestErrors_Localize(t *testing.T) {
+	tr := TranslatorFunc(func(code string, params map[string]interface{}) string {
+		switch code {
+		case "validation_required":
+			return "ne peut pas être vide"
+		case "validation_length_too_long":
+			return "la longueur ne doit pas dépasser {{.max}}"
+		}
+		return ""
+	})
+
+	c := struct {
+		Name    string
+		Address struct{ Street, City string }
+		Tags    []string
+	}{Tags: []string{"abc", "", "abcdef"}}
+	err := ValidateStruct(&c,
+		Field(&c.Name, Required),
+		Field(&c.Address, By(func(interface{}) error {
+			return ValidateStruct(&c.Address,
+				Field(&c.Address.Street, Required),
+				Field(&c.Address.City, Required.Error("city is missing")),
+			)
+		})),
+		Field(&c.Tags, Each(Required, Length(0, 5))),
+	)
+	errs, ok := err.(Errors)
+	if !assert.True(t, ok) {
+		return
+	}
+	errs["Internal"] = errors.New("abc")
+
+	localized := errs.Localize(tr)
+	assert.Equal(t, "Address: (City: ne peut pas être vide; Street: ne peut pas être vide.); Internal: abc; Name: ne peut pas être vide; Tags: (1: ne peut pas être vide; 2: la longueur ne doit pas dépasser 5.).", localized.Error())
+	assert.Equal(t, "Address: (City: city is missing; Street: cannot be blank.); Internal: abc; Name: cannot be blank; Tags: (1: cannot be blank; 2: the length must be no more than 5.).", errs.Error())
+}
+
+func TestFieldErrors(t *testing.T) {
+	errs := FieldErrors{ErrRequired, ErrLengthTooLong.SetParams(map[string]interface{}{"max": 5}), errors.New("abc")}
+	assert.Equal(t, "cannot be blank, the length must be no more than 5, abc", errs.Error())
+	assert.Equal(t, "cannot be blank, (0: abc; 1: cannot be blank.)", FieldErrors{ErrRequired, Errors{"0": errors.New("abc"), "1": ErrRequired}}.Error())
+
+	b, err := json.Marshal(Errors{"Name": errs})
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"Name":[{"code":"validation_required","message":"cannot be blank"},{"code":"validation_length_too_long","message":"the length must be no more than 5"},"abc"]}`, string(b))
+
+	tr := TranslatorFunc(func(code string, params map[string]interface{}) string {
+		if code == "validation_required" {
+			return "ne peut pas être vide"
+		}
+		return ""
+	})
+	localized := Errors{"Name": errs}.Localize(tr)
+	assert.Equal(t, "Name: ne peut pas être vide, the length must be no more than 5, abc.", localized.Error())
+	assert.Equal(t, "cannot be blank", errs[0].Error())
+}
+
 func TestError_Code(t *testing.T) {
 	err := NewError("A", "msg")
 
@@ -0,0 +1,66 @@
+package validate
+
+import "math"
+
+// ErrFixedPoint is the error that returns when a number cannot be represented with a fixed number of decimal places.
+var ErrFixedPoint = NewError("validation_fixed_point", "must be representable with {{.scale}} decimal places")
+
+// defaultFixedPointEpsilon is the relative tolerance used by FixedPoint to absorb floating point errors.
+const defaultFixedPointEpsilon = 1e-12
+
+// FixedPoint returns a validation rule that checks if a number can be stored as an integer scaled by 10^scale,
+// e.g. as cents for a scale of 2, without losing precision. The rule checks if value*10^scale is an integer
+// within a relative epsilon, which absorbs the rounding errors of binary floating point numbers such as 0.1.
+// The epsilon can be changed by calling Epsilon. The value must be of int, uint or float types.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func FixedPoint(scale int) FixedPointRule {
+	return FixedPointRule{
+		scale:   scale,
+		epsilon: defaultFixedPointEpsilon,
+		err:     ErrFixedPoint,
+	}
+}
+
+// FixedPointRule is a validation rule that checks if a number is representable in a fixed-point format.
+type FixedPointRule struct {
+	scale   int
+	epsilon float64
+	err     Error
+}
+
+// Epsilon sets the tolerance relative to the magnitude of the scaled value, which is at least 1.
+func (r FixedPointRule) Epsilon(epsilon float64) FixedPointRule {
+	r.epsilon = epsilon
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r FixedPointRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	n, err := ToNumber(value)
+	if err != nil {
+		return err
+	}
+
+	scaled := n * math.Pow10(r.scale)
+	if math.Abs(scaled-math.Round(scaled)) <= r.epsilon*math.Max(1, math.Abs(scaled)) {
+		return nil
+	}
+	return r.err.SetParams(map[string]interface{}{"scale": r.scale})
+}
+
+// Error sets the error message for the rule.
+func (r FixedPointRule) Error(message string) FixedPointRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r FixedPointRule) ErrorObject(err Error) FixedPointRule {
+	r.err = err
+	return r
+}
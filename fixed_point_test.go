@@ -0,0 +1,59 @@
+package validate
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFixedPoint(t *testing.T) {
+	var nilPtr *float64
+	amount := 19.99
+
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", 0.0, ""},
+		{"t2", nilPtr, ""},
+		{"t3", 19.99, ""},
+		{"t4", 0.1, ""},
+		{"t5", 0.3, ""},
+		{"t6", 1.005, "must be representable with 2 decimal places"},
+		{"t7", 19.999, "must be representable with 2 decimal places"},
+		{"t8", 12345678.91, ""},
+		{"t9", -0.07, ""},
+		{"t10", &amount, ""},
+		{"t11", float32(0.5), ""},
+		{"t12", 42, ""},
+		{"t13", math.NaN(), "must be representable with 2 decimal places"},
+		{"t14", "1.5", "cannot convert string to a number"},
+	}
+
+	for _, test := range tests {
+		err := FixedPoint(2).Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestFixedPointRule_Epsilon(t *testing.T) {
+	assert.NotNil(t, FixedPoint(0).Validate(1.001))
+	assert.Nil(t, FixedPoint(0).Epsilon(0.01).Validate(1.001))
+}
+
+func TestFixedPointRule_Error(t *testing.T) {
+	r := FixedPoint(0).Error("whole units only ({{.scale}})")
+	assert.Equal(t, "whole units only (0)", r.Validate(1.5).Error())
+}
+
+func TestFixedPointRule_ErrorObject(t *testing.T) {
+	r := FixedPoint(2)
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
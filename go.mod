@@ -4,5 +4,8 @@ go 1.13
 
 require (
 	github.com/asaskevich/govalidator v0.0.0-20200108200545-475eaeb16496
+	github.com/nyaruka/phonenumbers v1.0.56
 	github.com/stretchr/testify v1.4.0
+	golang.org/x/text v0.3.3
+	google.golang.org/protobuf v1.25.0
 )
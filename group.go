@@ -0,0 +1,73 @@
+package validate
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// ErrMaxPerGroup is the error that returns when a group of slice elements is too large.
+var ErrMaxPerGroup = NewError("validation_max_per_group", "'{{.key}}' appears too many times")
+
+// MaxPerGroup returns a validation rule that groups the elements of a slice or array by the key returned by
+// keyFn and checks if no group has more than max elements. The key of the first group that exceeds the limit
+// is reported in the "key" parameter of the error, which can be used in a custom message, e.g.
+//    validation.MaxPerGroup(func(item interface{}) interface{} {
+//        return item.(LineItem).ProductID
+//    }, 3).Error("product '{{.key}}' appears too many times")
+//
+// The keys must be comparable; otherwise the rule returns an internal error.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func MaxPerGroup(keyFn func(interface{}) interface{}, max int) MaxPerGroupRule {
+	return MaxPerGroupRule{
+		keyFn: keyFn,
+		max:   max,
+		err:   ErrMaxPerGroup,
+	}
+}
+
+// MaxPerGroupRule is a validation rule that limits the size of groups of slice elements.
+type MaxPerGroupRule struct {
+	keyFn func(interface{}) interface{}
+	max   int
+	err   Error
+}
+
+// Validate checks if the given value is valid or not.
+func (r MaxPerGroupRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	v, err := sliceValue(value)
+	if err != nil {
+		return err
+	}
+
+	counts := map[interface{}]int{}
+	for i := 0; i < v.Len(); i++ {
+		key := r.keyFn(v.Index(i).Interface())
+		if key != nil {
+			if key, err = comparableElem(reflect.ValueOf(key)); err != nil {
+				return err
+			}
+		}
+		counts[key]++
+		if counts[key] > r.max {
+			return r.err.SetParams(map[string]interface{}{"key": fmt.Sprint(key), "max": r.max})
+		}
+	}
+	return nil
+}
+
+// Error sets the error message for the rule.
+func (r MaxPerGroupRule) Error(message string) MaxPerGroupRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r MaxPerGroupRule) ErrorObject(err Error) MaxPerGroupRule {
+	r.err = err
+	return r
+}
@@ -0,0 +1,66 @@
+package validate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type orderLine struct {
+	Product string
+}
+
+func TestMaxPerGroup(t *testing.T) {
+	byProduct := func(v interface{}) interface{} { return v.(orderLine).Product }
+	var nilSlice []orderLine
+
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", nilSlice, ""},
+		{"t2", []orderLine{}, ""},
+		{"t3", []orderLine{{Product: "a"}, {Product: "b"}, {Product: "a"}}, ""},
+		{"t4", []orderLine{{Product: "a"}, {Product: "b"}, {Product: "b"}, {Product: "b"}, {Product: "a"}, {Product: "a"}}, "'b' appears too many times"},
+		{"t5", &[]orderLine{{Product: "c"}, {Product: "c"}, {Product: "c"}}, "'c' appears too many times"},
+		{"t6", orderLine{Product: "a"}, "must be a slice or an array"},
+	}
+
+	for _, test := range tests {
+		err := MaxPerGroup(byProduct, 2).Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestMaxPerGroup_Keys(t *testing.T) {
+	identity := func(v interface{}) interface{} { return v }
+
+	assert.Nil(t, MaxPerGroup(identity, 1).Validate([]interface{}{nil, 1}))
+	assert.EqualError(t, MaxPerGroup(identity, 1).Validate([]interface{}{nil, nil}), "'<nil>' appears too many times")
+
+	type box struct{ V interface{} }
+	for _, value := range []interface{}{[][]int{{1}}, []box{{[]int{1}}, {[]int{1}}}} {
+		err := MaxPerGroup(identity, 1).Validate(value)
+		if assert.NotNil(t, err) {
+			_, ok := err.(InternalError)
+			assert.True(t, ok)
+		}
+	}
+}
+
+func TestMaxPerGroupRule_Error(t *testing.T) {
+	identity := func(v interface{}) interface{} { return v }
+	r := MaxPerGroup(identity, 1).Error("product '{{.key}}' appears more than {{.max}} times")
+	assert.Equal(t, "product 'abc' appears more than 1 times", r.Validate([]string{"abc", "abc"}).Error())
+}
+
+func TestMaxPerGroupRule_ErrorObject(t *testing.T) {
+	r := MaxPerGroup(nil, 1)
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
@@ -22,10 +22,21 @@ func In(values ...interface{}) InRule {
 	}
 }
 
+// InBy returns a validation rule that checks if a value can be found in the list of values returned by f.
+// Unlike In, the list is resolved by calling f each time the rule validates a non-empty value, so it may be
+// loaded at runtime and change between calls. Values are compared the same way as for In.
+func InBy(f func() []interface{}) InRule {
+	return InRule{
+		elementsFn: f,
+		err:        ErrInInvalid,
+	}
+}
+
 // InRule is a validation rule that validates if a value can be found in the given list of values.
 type InRule struct {
-	elements []interface{}
-	err      Error
+	elements   []interface{}
+	elementsFn func() []interface{}
+	err        Error
 }
 
 // Validate checks if the given value is valid or not.
@@ -35,7 +46,12 @@ func (r InRule) Validate(value interface{}) error {
 		return nil
 	}
 
-	for _, e := range r.elements {
+	elements := r.elements
+	if r.elementsFn != nil {
+		elements = r.elementsFn()
+	}
+
+	for _, e := range elements {
 		if reflect.DeepEqual(e, value) {
 			return nil
 		}
@@ -0,0 +1,88 @@
+package validate
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+)
+
+// ErrInContext is the error that returns when a value is not in the allowed set found in the context.
+var ErrInContext = NewError("validation_in_context", "value is not permitted for your account")
+
+// InContext returns a context-aware validation rule that checks if a value is in the set of allowed values
+// stored in the context under the given key. This allows the allowed values to differ per request,
+// e.g. per tenant or role, without capturing them when the rule is built:
+//    ctx = context.WithValue(ctx, planKey, []string{"basic", "pro"})
+//    err := validation.ValidateWithContext(ctx, plan, validation.InContext(planKey))
+//
+// The allowed values can be stored as a slice or an array, or as a map whose keys are the allowed values.
+// reflect.DeepEqual() is used to compare values with slice elements. Values that cannot be used as map keys,
+// such as slices, are never in a map of allowed values.
+// If the context holds no value under the key, the rule returns an internal error.
+// The rule can only be used with ValidateWithContext or ValidateStructWithContext; Validate looks up the key
+// in an empty context and thus always fails for non-empty values.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func InContext(key interface{}) InContextRule {
+	return InContextRule{
+		key: key,
+		err: ErrInContext,
+	}
+}
+
+// InContextRule is a validation rule that checks if a value is in a set of allowed values stored in the context.
+type InContextRule struct {
+	key interface{}
+	err Error
+}
+
+// Validate checks if the given value is valid or not using an empty context.
+func (r InContextRule) Validate(value interface{}) error {
+	return r.ValidateWithContext(context.Background(), value)
+}
+
+// ValidateWithContext checks if the given value is valid or not using the given context.
+func (r InContextRule) ValidateWithContext(ctx context.Context, value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	allowed := ctx.Value(r.key)
+	if allowed == nil {
+		return NewInternalError(fmt.Errorf("no allowed values found in context under key %v", r.key))
+	}
+
+	rv := reflect.ValueOf(allowed)
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < rv.Len(); i++ {
+			if reflect.DeepEqual(rv.Index(i).Interface(), value) {
+				return nil
+			}
+		}
+	case reflect.Map:
+		v := reflect.ValueOf(value)
+		if v.Type().AssignableTo(rv.Type().Key()) && isComparable(v) && rv.MapIndex(v).IsValid() {
+			return nil
+		}
+	default:
+		return NewInternalError(fmt.Errorf("allowed values in context must be a slice, an array or a map, got %v", rv.Type()))
+	}
+
+	return r.err
+}
+
+// Error sets the error message for the rule.
+func (r InContextRule) Error(message string) InContextRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r InContextRule) ErrorObject(err Error) InContextRule {
+	r.err = err
+	return r
+}
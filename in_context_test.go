@@ -0,0 +1,79 @@
+package validate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type inContextKey struct{}
+
+func TestInContext(t *testing.T) {
+	plan := "pro"
+	var nilPtr *string
+
+	tests := []struct {
+		tag     string
+		allowed interface{}
+		value   interface{}
+		err     string
+	}{
+		{"t1", []string{"basic", "pro"}, "pro", ""},
+		{"t2", []string{"basic", "pro"}, "enterprise", "value is not permitted for your account"},
+		{"t3", []string{"basic", "pro"}, "", ""},
+		{"t4", []string{"basic", "pro"}, &plan, ""},
+		{"t5", []string{"basic", "pro"}, nilPtr, ""},
+		{"t6", [2]int{1, 2}, 2, ""},
+		{"t7", map[string]bool{"pro": true}, "pro", ""},
+		{"t8", map[string]bool{"pro": true}, "basic", "value is not permitted for your account"},
+		{"t9", map[string]bool{"pro": true}, 1, "value is not permitted for your account"},
+		{"t10", nil, "pro", "no allowed values found in context under key {}"},
+		{"t11", "pro", "pro", "allowed values in context must be a slice, an array or a map, got string"},
+		{"t12", map[interface{}]bool{"pro": true}, []int{1}, "value is not permitted for your account"},
+		{"t13", map[interface{}]bool{"pro": true}, struct{ V interface{} }{[]int{1}}, "value is not permitted for your account"},
+	}
+
+	for _, test := range tests {
+		ctx := context.Background()
+		if test.allowed != nil {
+			ctx = context.WithValue(ctx, inContextKey{}, test.allowed)
+		}
+		err := InContext(inContextKey{}).ValidateWithContext(ctx, test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestInContextRule_Validate(t *testing.T) {
+	r := InContext(inContextKey{})
+	assert.Nil(t, r.Validate(""))
+
+	err := r.Validate("pro")
+	if assert.NotNil(t, err) {
+		_, ok := err.(InternalError)
+		assert.True(t, ok)
+	}
+}
+
+func TestInContext_ValidateWithContext(t *testing.T) {
+	ctx := context.WithValue(context.Background(), inContextKey{}, []string{"basic"})
+	assert.Nil(t, ValidateWithContext(ctx, "basic", InContext(inContextKey{})))
+	assert.EqualError(t, ValidateWithContext(ctx, "pro", InContext(inContextKey{})), "value is not permitted for your account")
+}
+
+func TestInContextRule_Error(t *testing.T) {
+	ctx := context.WithValue(context.Background(), inContextKey{}, []string{"basic"})
+	r := InContext(inContextKey{}).Error("123")
+	assert.Equal(t, "123", r.err.Message())
+	assert.Equal(t, "123", r.ValidateWithContext(ctx, "pro").Error())
+}
+
+func TestInContextRule_ErrorObject(t *testing.T) {
+	r := InContext(inContextKey{})
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
@@ -37,6 +37,39 @@ func TestIn(t *testing.T) {
 	}
 }
 
+func TestInBy(t *testing.T) {
+	calls := 0
+	allowed := []interface{}{1, 2}
+	r := InBy(func() []interface{} {
+		calls++
+		return allowed
+	})
+
+	assert.Nil(t, r.Validate(1))
+	assert.Equal(t, ErrInInvalid, r.Validate(3))
+	assert.Equal(t, ErrInInvalid, r.Validate("1"))
+	assert.Equal(t, 3, calls)
+
+	// empty values are valid without resolving the list
+	var v *int
+	assert.Nil(t, r.Validate(0))
+	assert.Nil(t, r.Validate(v))
+	assert.Equal(t, 3, calls)
+
+	// the list is resolved on each call
+	allowed = []interface{}{3}
+	assert.Nil(t, r.Validate(3))
+	assert.Equal(t, ErrInInvalid, r.Validate(1))
+	assert.Equal(t, 5, calls)
+
+	allowed = nil
+	assert.Equal(t, ErrInInvalid, r.Validate(3))
+
+	r = InBy(func() []interface{} { return []interface{}{[]byte{1}} }).Error("not allowed")
+	assert.Nil(t, r.Validate([]byte{1}))
+	assert.EqualError(t, r.Validate([]byte{2}), "not allowed")
+}
+
 func Test_InRule_Error(t *testing.T) {
 	r := In(1, 2, 3)
 	val := 4
@@ -0,0 +1,66 @@
+package validate
+
+// ErrIndexOutOfRange is the error that returns when an index is out of the range of a slice.
+var ErrIndexOutOfRange = NewError("validation_index_out_of_range", "index is out of range")
+
+// IndexInto returns a validation rule that checks if an integer value is a valid index into a slice or array,
+// i.e. 0 <= value < len. slicePtr should be a pointer to a sibling slice or array field, which makes the rule
+// usable within ValidateStruct. For example,
+//    validation.Field(&s.SelectedIndex, validation.IndexInto(&s.Options))
+//
+// A nil or empty slice has no valid index, so every index fails.
+// Unlike most rules, a zero value is not considered empty because 0 is a valid index.
+// A nil pointer is considered valid. Use the Required or NotNil rule to make sure a value is present.
+func IndexInto(slicePtr interface{}) IndexIntoRule {
+	return IndexIntoRule{
+		slice: slicePtr,
+		err:   ErrIndexOutOfRange,
+	}
+}
+
+// IndexIntoRule is a validation rule that checks if an integer value is a valid index into a slice.
+type IndexIntoRule struct {
+	slice interface{}
+	err   Error
+}
+
+// Validate checks if the given value is valid or not.
+func (r IndexIntoRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil {
+		return nil
+	}
+
+	index, err := toInt64(value)
+	if err == errIntOverflow {
+		return r.err
+	} else if err != nil {
+		return err
+	}
+
+	length := 0
+	if s, isNil := Indirect(r.slice); !isNil {
+		sv, err := sliceValue(s)
+		if err != nil {
+			return err
+		}
+		length = sv.Len()
+	}
+
+	if index < 0 || index >= int64(length) {
+		return r.err
+	}
+	return nil
+}
+
+// Error sets the error message for the rule.
+func (r IndexIntoRule) Error(message string) IndexIntoRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r IndexIntoRule) ErrorObject(err Error) IndexIntoRule {
+	r.err = err
+	return r
+}
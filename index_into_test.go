@@ -0,0 +1,74 @@
+package validate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIndexInto(t *testing.T) {
+	options := []string{"a", "b", "c"}
+	var empty []string
+	var nilPtr *int
+	arr := [2]int{1, 2}
+	str := "abc"
+	idx := 2
+
+	tests := []struct {
+		tag   string
+		slice interface{}
+		value interface{}
+		err   string
+	}{
+		{"t1", &options, 0, ""},
+		{"t2", &options, 2, ""},
+		{"t3", &options, 3, "index is out of range"},
+		{"t4", &options, -1, "index is out of range"},
+		{"t5", &options, uint(1), ""},
+		{"t6", &options, &idx, ""},
+		{"t7", &options, nilPtr, ""},
+		{"t8", &empty, 0, "index is out of range"},
+		{"t9", nil, 0, "index is out of range"},
+		{"t10", &arr, 1, ""},
+		{"t11", &arr, 2, "index is out of range"},
+		{"t12", &options, "1", "cannot convert string to int64"},
+		{"t13", &str, 0, "must be a slice or an array"},
+	}
+
+	for _, test := range tests {
+		r := IndexInto(test.slice)
+		err := r.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestIndexInto_ValidateStruct(t *testing.T) {
+	s := struct {
+		Options       []string
+		SelectedIndex int
+	}{Options: []string{"a"}, SelectedIndex: 1}
+
+	err := ValidateStruct(&s, Field(&s.SelectedIndex, IndexInto(&s.Options)))
+	assert.EqualError(t, err, "SelectedIndex: index is out of range.")
+
+	s.SelectedIndex = 0
+	assert.Nil(t, ValidateStruct(&s, Field(&s.SelectedIndex, IndexInto(&s.Options))))
+}
+
+func TestIndexIntoRule_Error(t *testing.T) {
+	r := IndexInto(nil)
+	assert.Equal(t, "index is out of range", r.Validate(0).Error())
+
+	r = r.Error("selected index is out of range")
+	assert.Equal(t, "selected index is out of range", r.Validate(0).Error())
+}
+
+func TestIndexIntoRule_ErrorObject(t *testing.T) {
+	r := IndexInto(nil)
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
@@ -0,0 +1,104 @@
+package validate
+
+import (
+	"fmt"
+	"strconv"
+)
+
+var (
+	// ErrIntBase is the error that returns when a string is not a valid integer in a given base.
+	ErrIntBase = NewError("validation_int_base", "must be a valid base-{{.base}} integer")
+	// ErrIntBaseAuto is the error that returns when a string is not a valid integer with an optional base prefix.
+	ErrIntBaseAuto = NewError("validation_int_base_auto", "must be a valid integer")
+	// ErrIntBaseOutOfRange is the error that returns when a parsed integer is out of range.
+	ErrIntBaseOutOfRange = NewError("validation_int_base_out_of_range", "the integer is out of range")
+)
+
+// IntBase returns a validation rule that checks if a string is an integer in the given base, as parsed by
+// strconv.ParseInt(value, base, 64). The base must be 0 or between 2 and 36. A base of 0 detects the base from
+// the prefix of the string: "0x" for base 16, "0" for base 8 and base 10 otherwise.
+// By calling Min() and/or Max(), you can let the rule check if the parsed integer is within the specified range.
+// If the base is invalid, the rule returns an internal error.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func IntBase(base int) IntBaseRule {
+	err := ErrIntBase.SetParams(map[string]interface{}{"base": base})
+	if base == 0 {
+		err = ErrIntBaseAuto
+	}
+	return IntBaseRule{
+		base:     base,
+		err:      err,
+		rangeErr: ErrIntBaseOutOfRange,
+	}
+}
+
+// IntBaseRule is a validation rule that checks if a string is an integer in a given base.
+type IntBaseRule struct {
+	base           int
+	min, max       int64
+	hasMin, hasMax bool
+	err, rangeErr  Error
+}
+
+// Min sets the minimum value of the parsed integer.
+func (r IntBaseRule) Min(min int64) IntBaseRule {
+	r.min, r.hasMin = min, true
+	return r
+}
+
+// Max sets the maximum value of the parsed integer.
+func (r IntBaseRule) Max(max int64) IntBaseRule {
+	r.max, r.hasMax = max, true
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r IntBaseRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	str, err := EnsureString(value)
+	if err != nil {
+		return err
+	}
+
+	if r.base != 0 && (r.base < 2 || r.base > 36) {
+		return NewInternalError(fmt.Errorf("invalid base %v", r.base))
+	}
+
+	n, err := strconv.ParseInt(str, r.base, 64)
+	if err != nil {
+		return r.err
+	}
+
+	if r.hasMin && n < r.min || r.hasMax && n > r.max {
+		return r.rangeErr
+	}
+	return nil
+}
+
+// Error sets the error message that is used when the value is not a valid integer.
+func (r IntBaseRule) Error(message string) IntBaseRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct that is used when the value is not a valid integer.
+func (r IntBaseRule) ErrorObject(err Error) IntBaseRule {
+	r.err = err
+	return r
+}
+
+// RangeError sets the error message that is used when the integer is out of the specified Min/Max range.
+func (r IntBaseRule) RangeError(message string) IntBaseRule {
+	r.rangeErr = r.rangeErr.SetMessage(message)
+	return r
+}
+
+// RangeErrorObject sets the error struct that is used when the integer is out of the specified Min/Max range.
+func (r IntBaseRule) RangeErrorObject(err Error) IntBaseRule {
+	r.rangeErr = err
+	return r
+}
@@ -0,0 +1,68 @@
+package validate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIntBase(t *testing.T) {
+	tests := []struct {
+		tag   string
+		base  int
+		value interface{}
+		err   string
+	}{
+		{"t1", 16, "", ""},
+		{"t2", 16, "ff", ""},
+		{"t3", 16, "-7F", ""},
+		{"t4", 16, "fg", "must be a valid base-16 integer"},
+		{"t5", 8, "755", ""},
+		{"t6", 8, "789", "must be a valid base-8 integer"},
+		{"t7", 2, "1010", ""},
+		{"t8", 2, "102", "must be a valid base-2 integer"},
+		{"t9", 0, "0x1f", ""},
+		{"t10", 0, "0755", ""},
+		{"t11", 0, "42", ""},
+		{"t12", 0, "0x1g", "must be a valid integer"},
+		{"t13", 10, "9223372036854775808", "must be a valid base-10 integer"},
+		{"t14", 1, "1", "invalid base 1"},
+		{"t15", 37, "1", "invalid base 37"},
+		{"t16", 16, 255, "must be either a string or byte slice"},
+	}
+
+	for _, test := range tests {
+		r := IntBase(test.base)
+		err := r.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestIntBaseRule_MinMax(t *testing.T) {
+	r := IntBase(16).Min(0x10).Max(0xff)
+	assert.Nil(t, r.Validate("10"))
+	assert.Nil(t, r.Validate("ff"))
+	assert.EqualError(t, r.Validate("f"), "the integer is out of range")
+	assert.EqualError(t, r.Validate("100"), "the integer is out of range")
+
+	r = IntBase(10).Max(0)
+	assert.Nil(t, r.Validate("-5"))
+	assert.EqualError(t, r.Validate("1"), "the integer is out of range")
+}
+
+func TestIntBaseRule_Error(t *testing.T) {
+	r := IntBase(16).Max(1).Error("bad hex").RangeError("too big")
+	assert.Equal(t, "bad hex", r.Validate("xyz").Error())
+	assert.Equal(t, "too big", r.Validate("2").Error())
+}
+
+func TestIntBaseRule_ErrorObject(t *testing.T) {
+	r := IntBase(16)
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err).RangeErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err, r.rangeErr)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
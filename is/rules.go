@@ -6,7 +6,18 @@
 package is
 
 import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"net/url"
 	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 	"unicode"
 
 	"github.com/asaskevich/govalidator"
@@ -22,6 +33,8 @@ var (
 	ErrRequestURL = validate.NewError("validation_is_request_url", "must be a valid request URL")
 	// ErrRequestURI is the error that returns in case of an invalid request URI.
 	ErrRequestURI = validate.NewError("validation_request_is_request_uri", "must be a valid request URI")
+	// ErrRelativeURL is the error that returns in case of an invalid relative URL.
+	ErrRelativeURL = validate.NewError("validation_is_relative_url", "must be a valid relative URL")
 	// ErrAlpha is the error that returns in case of an invalid alpha value.
 	ErrAlpha = validate.NewError("validation_is_alpha", "must contain English letters only")
 	// ErrDigit is the error that returns in case of an invalid digit value.
@@ -66,8 +79,12 @@ var (
 	ErrISBN13 = validate.NewError("validation_is_isbn_13", "must be a valid ISBN-13")
 	// ErrISBN is the error that returns in case of an invalid ISBN value.
 	ErrISBN = validate.NewError("validation_is_isbn", "must be a valid ISBN")
+	// ErrQueryString is the error that returns in case of an invalid query string.
+	ErrQueryString = validate.NewError("validation_is_query_string", "must be a valid query string")
+	// ErrQueryStringParam is the error that returns in case of a query parameter that is not allowed.
+	ErrQueryStringParam = validate.NewError("validation_is_query_string_param", "query parameter {{.param}} is not allowed")
 	// ErrJSON is the error that returns in case of an invalid JSON.
-	ErrJSON = validate.NewError("validation_is_json", "must be in valid JSON format")
+	ErrJSON = validate.NewError("validation_is_json", "must be a valid JSON string")
 	// ErrASCII is the error that returns in case of an invalid ASCII.
 	ErrASCII = validate.NewError("validation_is_ascii", "must contain ASCII characters only")
 	// ErrPrintableASCII is the error that returns in case of an invalid printable ASCII value.
@@ -80,12 +97,14 @@ var (
 	ErrHalfWidth = validate.NewError("validation_is_half_width", "must contain half-width characters")
 	// ErrVariableWidth is the error that returns in case of an invalid variable width value.
 	ErrVariableWidth = validate.NewError("validation_is_variable_width", "must contain both full-width and half-width characters")
-	// ErrBase64 is the error that returns in case of an invalid base54 value.
+	// ErrBase64 is the error that returns in case of an invalid base64 value.
 	ErrBase64 = validate.NewError("validation_is_base64", "must be encoded in Base64")
+	// ErrBase64URL is the error that returns in case of an invalid URL-safe base64 value.
+	ErrBase64URL = validate.NewError("validation_is_base64_url", "must be encoded in Base64 URL format")
 	// ErrDataURI is the error that returns in case of an invalid data URI.
 	ErrDataURI = validate.NewError("validation_is_data_uri", "must be a Base64-encoded data URI")
-	// ErrE164 is the error that returns in case of an invalid e165.
-	ErrE164 = validate.NewError("validation_is_e164_number", "must be a valid E164 number")
+	// ErrE164 is the error that returns in case of an invalid E.164 phone number.
+	ErrE164 = validate.NewError("validation_is_e164_number", "must be a valid E.164 phone number")
 	// ErrCountryCode2 is the error that returns in case of an invalid two-letter country code.
 	ErrCountryCode2 = validate.NewError("validation_is_country_code_2_letter", "must be a valid two-letter country code")
 	// ErrCountryCode3 is the error that returns in case of an invalid three-letter country code.
@@ -112,6 +131,8 @@ var (
 	ErrHost = validate.NewError("validation_is_host", "must be a valid IP address or DNS name")
 	// ErrPort is the error that returns in case of an invalid port.
 	ErrPort = validate.NewError("validation_is_port", "must be a valid port number")
+	// ErrHostPort is the error that returns in case of an invalid host:port combination.
+	ErrHostPort = validate.NewError("validation_is_host_port", "must be a valid host:port")
 	// ErrMongoID is the error that returns in case of an invalid MongoID.
 	ErrMongoID = validate.NewError("validation_is_mongo_id", "must be a valid hex-encoded MongoDB ObjectId")
 	// ErrLatitude is the error that returns in case of an invalid latitude.
@@ -120,6 +141,16 @@ var (
 	ErrLongitude = validate.NewError("validation_is_longitude", "must be a valid longitude")
 	// ErrSSN is the error that returns in case of an invalid SSN.
 	ErrSSN = validate.NewError("validation_is_ssn", "must be a valid social security number")
+	// ErrVIN is the error that returns in case of an invalid VIN.
+	ErrVIN = validate.NewError("validation_is_vin", "must be a valid VIN")
+	// ErrBarcode is the error that returns in case of an invalid GTIN, EAN or UPC barcode.
+	ErrBarcode = validate.NewError("validation_is_barcode", "must be a valid barcode")
+	// ErrResourceQuantity is the error that returns in case of an invalid resource quantity.
+	ErrResourceQuantity = validate.NewError("validation_is_resource_quantity", "must be a valid resource quantity")
+	// ErrResourceQuantityRange is the error that returns in case of an invalid or out-of-range resource quantity.
+	ErrResourceQuantityRange = validate.NewError("validation_is_resource_quantity_range", "must be a valid resource quantity between {{.min}} and {{.max}}")
+	// ErrTimezone is the error that returns in case of an invalid timezone name.
+	ErrTimezone = validate.NewError("validation_is_timezone", "must be a valid timezone")
 	// ErrSemver is the error that returns in case of an invalid semver.
 	ErrSemver = validate.NewError("validation_is_semver", "must be a valid semantic version")
 )
@@ -135,6 +166,10 @@ var (
 	RequestURL = validate.NewStringRuleWithError(govalidator.IsRequestURL, ErrRequestURL)
 	// RequestURI validates if a string is a valid request URI
 	RequestURI = validate.NewStringRuleWithError(govalidator.IsRequestURI, ErrRequestURI)
+	// RelativeURL validates if a string is a valid relative URL reference without scheme and host, e.g. /path?q=1#frag
+	RelativeURL = validate.NewStringRuleWithError(isRelativeURL, ErrRelativeURL)
+	// RelativeURLWithHost validates if a string is a valid relative URL reference, including protocol-relative ones like //host/path
+	RelativeURLWithHost = validate.NewStringRuleWithError(isRelativeURLWithHost, ErrRelativeURL)
 	// Alpha validates if a string contains English letters only (a-zA-Z)
 	Alpha = validate.NewStringRuleWithError(govalidator.IsAlpha, ErrAlpha)
 	// Digit validates if a string contains digits only (0-9)
@@ -149,9 +184,11 @@ var (
 	UTFLetterNumeric = validate.NewStringRuleWithError(govalidator.IsUTFLetterNumeric, ErrUTFLetterNumeric)
 	// UTFNumeric validates if a string contains unicode number characters (category N) only
 	UTFNumeric = validate.NewStringRuleWithError(isUTFNumeric, ErrUTFNumeric)
-	// LowerCase validates if a string contains lower case unicode letters only
+	// LowerCase validates if a string equals its strings.ToLower form.
+	// Characters without case, such as digits, are ignored, so "abc-123" is valid.
 	LowerCase = validate.NewStringRuleWithError(govalidator.IsLowerCase, ErrLowerCase)
-	// UpperCase validates if a string contains upper case unicode letters only
+	// UpperCase validates if a string equals its strings.ToUpper form.
+	// Characters without case, such as digits, are ignored, so "ABC-123" is valid.
 	UpperCase = validate.NewStringRuleWithError(govalidator.IsUpperCase, ErrUpperCase)
 	// Hexadecimal validates if a string is a valid hexadecimal number
 	Hexadecimal = validate.NewStringRuleWithError(govalidator.IsHexadecimal, ErrHexadecimal)
@@ -171,16 +208,19 @@ var (
 	UUIDv5 = validate.NewStringRuleWithError(govalidator.IsUUIDv5, ErrUUIDv5)
 	// UUID validates if a string is a valid UUID
 	UUID = validate.NewStringRuleWithError(govalidator.IsUUID, ErrUUID)
-	// CreditCard validates if a string is a valid credit card number
-	CreditCard = validate.NewStringRuleWithError(govalidator.IsCreditCard, ErrCreditCard)
+	// CreditCard validates if a string is a valid credit card number: 13 to 19 digits passing the Luhn checksum.
+	// Spaces and dashes are ignored.
+	CreditCard = validate.NewStringRuleWithError(isCreditCard, ErrCreditCard)
 	// ISBN10 validates if a string is an ISBN version 10
 	ISBN10 = validate.NewStringRuleWithError(govalidator.IsISBN10, ErrISBN10)
 	// ISBN13 validates if a string is an ISBN version 13
 	ISBN13 = validate.NewStringRuleWithError(govalidator.IsISBN13, ErrISBN13)
 	// ISBN validates if a string is an ISBN (either version 10 or 13)
 	ISBN = validate.NewStringRuleWithError(isISBN, ErrISBN)
-	// JSON validates if a string is in valid JSON format
-	JSON = validate.NewStringRuleWithError(govalidator.IsJSON, ErrJSON)
+	// QueryString validates if a string is a valid percent-encoded query string, e.g. a=1&b=x%20y
+	QueryString = QueryStringRule{err: ErrQueryString, paramErr: ErrQueryStringParam}
+	// JSON validates if a string or byte slice is a well-formed JSON document
+	JSON = JSONRule{err: ErrJSON}
 	// ASCII validates if a string contains ASCII characters only
 	ASCII = validate.NewStringRuleWithError(govalidator.IsASCII, ErrASCII)
 	// PrintableASCII validates if a string contains printable ASCII characters only
@@ -193,11 +233,14 @@ var (
 	HalfWidth = validate.NewStringRuleWithError(govalidator.IsHalfWidth, ErrHalfWidth)
 	// VariableWidth validates if a string contains both full-width and half-width characters
 	VariableWidth = validate.NewStringRuleWithError(govalidator.IsVariableWidth, ErrVariableWidth)
-	// Base64 validates if a string is encoded in Base64
-	Base64 = validate.NewStringRuleWithError(govalidator.IsBase64, ErrBase64)
+	// Base64 validates if a string is encoded in Base64 using the standard alphabet with padding
+	Base64 = validate.NewStringRuleWithError(isBase64, ErrBase64)
+	// Base64URL validates if a string is encoded in Base64 using the URL-safe alphabet, with or without padding
+	Base64URL = validate.NewStringRuleWithError(isBase64URL, ErrBase64URL)
 	// DataURI validates if a string is a valid base64-encoded data URI
 	DataURI = validate.NewStringRuleWithError(govalidator.IsDataURI, ErrDataURI)
-	// E164 validates if a string is a valid ISO3166 Alpha 2 country code
+	// E164 validates if a string is a phone number in E.164 format: a "+" followed by up to 15 digits,
+	// the first of which is not 0, e.g. "+14155552671". Spaces, dashes and parentheses are not allowed.
 	E164 = validate.NewStringRuleWithError(isE164Number, ErrE164)
 	// CountryCode2 validates if a string is a valid ISO3166 Alpha 2 country code
 	CountryCode2 = validate.NewStringRuleWithError(govalidator.IsISO3166Alpha2, ErrCountryCode2)
@@ -225,24 +268,57 @@ var (
 	Host = validate.NewStringRuleWithError(govalidator.IsHost, ErrHost)
 	// Port validates if a string is a valid port number
 	Port = validate.NewStringRuleWithError(govalidator.IsPort, ErrPort)
+	// HostPort validates if a string is a valid host:port combination, where the host is an IP or a DNS name.
+	// IPv6 hosts must be enclosed in square brackets, e.g. [::1]:8080
+	HostPort = validate.NewStringRuleWithError(isHostPort, ErrHostPort)
 	// MongoID validates if a string is a valid Mongo ID
 	MongoID = validate.NewStringRuleWithError(govalidator.IsMongoID, ErrMongoID)
 	// Latitude validates if a string is a valid latitude
 	Latitude = validate.NewStringRuleWithError(govalidator.IsLatitude, ErrLatitude)
 	// Longitude validates if a string is a valid longitude
 	Longitude = validate.NewStringRuleWithError(govalidator.IsLongitude, ErrLongitude)
-	// SSN validates if a string is a social security number (SSN)
-	SSN = validate.NewStringRuleWithError(govalidator.IsSSN, ErrSSN)
-	// Semver validates if a string is a valid semantic version
-	Semver = validate.NewStringRuleWithError(govalidator.IsSemver, ErrSemver)
+	// SSN validates if a string is a social security number (SSN).
+	// Besides the format, it rejects numbers from ranges that are never assigned (area 000, 666 and 900-999,
+	// group 00 and serial 0000).
+	SSN = validate.NewStringRuleWithError(isSSN, ErrSSN)
+	// VIN validates if a string is a 17-character vehicle identification number (VIN) with a valid check digit
+	VIN = validate.NewStringRuleWithError(isVIN, ErrVIN)
+	// EAN13 validates if a string is a 13-digit EAN barcode with a valid check digit. Spaces are ignored.
+	EAN13 = validate.NewStringRuleWithError(isEAN13, ErrBarcode)
+	// UPCA validates if a string is a 12-digit UPC-A barcode with a valid check digit. Spaces are ignored.
+	UPCA = validate.NewStringRuleWithError(isUPCA, ErrBarcode)
+	// GTIN validates if a string is a GTIN-8, GTIN-12, GTIN-13 or GTIN-14 barcode with a valid check digit.
+	// Spaces are ignored.
+	GTIN = validate.NewStringRuleWithError(isGTIN, ErrBarcode)
+	// ResourceQuantity validates if a string is a Kubernetes-style resource quantity, e.g. "500m", "2Gi" or "1.5"
+	ResourceQuantity = validate.NewStringRuleWithError(isResourceQuantity, ErrResourceQuantity)
+	// Timezone validates if a string is an IANA time zone name that can be loaded with LoadLocation,
+	// e.g. "America/New_York" or "UTC". "Local" is not accepted, as it depends on the system.
+	Timezone = validate.NewStringRuleWithError(isTimezone, ErrTimezone)
+	// Semver validates if a string is a valid semantic version as defined by SemVer 2.0.0, e.g. "2.0.0-rc.1+build".
+	// A leading "v" is not allowed.
+	Semver = validate.NewStringRuleWithError(isSemver, ErrSemver)
 )
 
+// LoadLocation loads the time zones validated by Timezone. It defaults to time.LoadLocation, which depends on
+// the time zone database of the system: without it, only "UTC" is valid. The database can be embedded into the
+// program by importing the time/tzdata package (Go 1.15+), or LoadLocation may be replaced, e.g. in tests.
+var LoadLocation = time.LoadLocation
+
 var (
 	reDigit = regexp.MustCompile("^[0-9]+$")
+	// Resource quantity suffixes: binary SI, decimal SI or a decimal exponent
+	reResourceQuantity = regexp.MustCompile(`^([+-]?(?:\d+(?:\.\d*)?|\.\d+))(Ki|Mi|Gi|Ti|Pi|Ei|n|u|m|k|M|G|T|P|E|[eE][+-]?\d+)?$`)
+	reSSN              = regexp.MustCompile(`^(\d{3})[- ]?(\d{2})[- ]?(\d{4})$`)
 	// Subdomain regex source: https://stackoverflow.com/a/7933253
 	reSubdomain = regexp.MustCompile(`^[A-Za-z0-9](?:[A-Za-z0-9\-]{0,61}[A-Za-z0-9])?$`)
 	// E164 regex source: https://stackoverflow.com/a/23299989
-	reE164 = regexp.MustCompile(`^\+?[1-9]\d{1,14}$`)
+	// Modified to require the leading "+".
+	reE164 = regexp.MustCompile(`^\+[1-9]\d{1,14}$`)
+	// Semver regex source: https://semver.org/#is-there-a-suggested-regular-expression-regex-to-check-a-semver-string
+	reSemver = regexp.MustCompile(`^(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)` +
+		`(?:-((?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*)(?:\.(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?` +
+		`(?:\+([0-9a-zA-Z-]+(?:\.[0-9a-zA-Z-]+)*))?$`)
 	// Domain regex source: https://stackoverflow.com/a/7933253
 	// Slightly modified: Removed 255 max length validation since Go regex does not
 	// support lookarounds. More info: https://stackoverflow.com/a/38935027
@@ -261,6 +337,18 @@ func isE164Number(value string) bool {
 	return reE164.MatchString(value)
 }
 
+func isSemver(value string) bool {
+	return reSemver.MatchString(value)
+}
+
+func isTimezone(value string) bool {
+	if value == "Local" {
+		return false
+	}
+	_, err := LoadLocation(value)
+	return err == nil
+}
+
 func isSubdomain(value string) bool {
 	return reSubdomain.MatchString(value)
 }
@@ -273,6 +361,388 @@ func isDomain(value string) bool {
 	return reDomain.MatchString(value)
 }
 
+func isHostPort(value string) bool {
+	host, port, err := net.SplitHostPort(value)
+	if err != nil {
+		return false
+	}
+	return govalidator.IsHost(host) && govalidator.IsPort(port)
+}
+
+func isBase64(value string) bool {
+	// the decoder ignores line breaks, which are rejected explicitly
+	if strings.ContainsAny(value, "\r\n") {
+		return false
+	}
+	_, err := base64.StdEncoding.DecodeString(value)
+	return err == nil
+}
+
+func isBase64URL(value string) bool {
+	if strings.ContainsAny(value, "\r\n") {
+		return false
+	}
+	if strings.HasSuffix(value, "=") {
+		_, err := base64.URLEncoding.DecodeString(value)
+		return err == nil
+	}
+	_, err := base64.RawURLEncoding.DecodeString(value)
+	return err == nil
+}
+
+func isRelativeURL(value string) bool {
+	return !strings.HasPrefix(value, "//") && isRelativeURLWithHost(value)
+}
+
+func isRelativeURLWithHost(value string) bool {
+	if strings.ContainsAny(value, " \t\r\n") {
+		return false
+	}
+	u, err := url.Parse(value)
+	return err == nil && u.Scheme == ""
+}
+
+// JSONRule is a validation rule that checks if a string or byte slice is a well-formed JSON document.
+// It uses json.Valid, so the document is not decoded. Unlike the other rules of this package,
+// it returns an internal error if the value is neither a string nor a byte slice.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+type JSONRule struct {
+	err validate.Error
+}
+
+// Validate checks if the given value is valid or not.
+func (r JSONRule) Validate(value interface{}) error {
+	value, isNil := validate.Indirect(value)
+	if isNil || validate.IsEmpty(value) {
+		return nil
+	}
+
+	var data []byte
+	switch v := value.(type) {
+	case string:
+		data = []byte(v)
+	case []byte:
+		data = v
+	default:
+		return validate.NewInternalError(errors.New("must be either a string or byte slice"))
+	}
+
+	if json.Valid(data) {
+		return nil
+	}
+	return r.err
+}
+
+// Error sets the error message for the rule.
+func (r JSONRule) Error(message string) JSONRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r JSONRule) ErrorObject(err validate.Error) JSONRule {
+	r.err = err
+	return r
+}
+
+// QueryStringRule is a validation rule that checks if a string is a valid percent-encoded query string.
+// A query string is invalid if url.ParseQuery fails, e.g. because of a bad percent-encoding or a semicolon,
+// or if a parameter has an empty name. Call AllowedParams to restrict the parameter names.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+type QueryStringRule struct {
+	allowed  map[string]bool
+	err      validate.Error
+	paramErr validate.Error
+}
+
+// AllowedParams restricts the names of the parameters to the given ones. The first parameter that is not allowed
+// is reported in the "param" parameter of the error.
+func (r QueryStringRule) AllowedParams(names ...string) QueryStringRule {
+	r.allowed = make(map[string]bool, len(names))
+	for _, name := range names {
+		r.allowed[name] = true
+	}
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r QueryStringRule) Validate(value interface{}) error {
+	value, isNil := validate.Indirect(value)
+	if isNil || validate.IsEmpty(value) {
+		return nil
+	}
+
+	str, err := validate.EnsureString(value)
+	if err != nil {
+		return err
+	}
+
+	// semicolons are rejected explicitly since url.ParseQuery accepts them as separators before Go 1.17
+	params, err := url.ParseQuery(str)
+	if err != nil || strings.Contains(str, ";") {
+		return r.err
+	}
+	names := make([]string, 0, len(params))
+	for name := range params {
+		if name == "" {
+			return r.err
+		}
+		names = append(names, name)
+	}
+	if r.allowed == nil {
+		return nil
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if !r.allowed[name] {
+			return r.paramErr.SetParams(map[string]interface{}{"param": name})
+		}
+	}
+	return nil
+}
+
+// Error sets the error message for the rule.
+func (r QueryStringRule) Error(message string) QueryStringRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r QueryStringRule) ErrorObject(err validate.Error) QueryStringRule {
+	r.err = err
+	return r
+}
+
+// ParamError sets the error message for a parameter that is not allowed.
+func (r QueryStringRule) ParamError(message string) QueryStringRule {
+	r.paramErr = r.paramErr.SetMessage(message)
+	return r
+}
+
+// ParamErrorObject sets the error struct for a parameter that is not allowed.
+func (r QueryStringRule) ParamErrorObject(err validate.Error) QueryStringRule {
+	r.paramErr = err
+	return r
+}
+
+// ResourceQuantityBetween returns a validation rule that checks if a string is a Kubernetes-style resource quantity
+// between min and max inclusively. The bounds are given as quantities too, e.g.
+//
+//	is.ResourceQuantityBetween("100m", "4")
+//	is.ResourceQuantityBetween("64Mi", "2Gi")
+//
+// If min or max is not a valid resource quantity, the rule returns an internal error when validating.
+func ResourceQuantityBetween(min, max string) ResourceQuantityRangeRule {
+	r := ResourceQuantityRangeRule{
+		err: ErrResourceQuantityRange.SetParams(map[string]interface{}{"min": min, "max": max}),
+	}
+	var ok bool
+	if r.min, ok = ParseResourceQuantity(min); !ok {
+		r.boundErr = fmt.Errorf("invalid minimum resource quantity %q", min)
+	} else if r.max, ok = ParseResourceQuantity(max); !ok {
+		r.boundErr = fmt.Errorf("invalid maximum resource quantity %q", max)
+	}
+	return r
+}
+
+// ResourceQuantityRangeRule is a validation rule that checks if a string is a resource quantity within a range.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+type ResourceQuantityRangeRule struct {
+	min, max float64
+	boundErr error
+	err      validate.Error
+}
+
+// Validate checks if the given value is valid or not.
+func (r ResourceQuantityRangeRule) Validate(value interface{}) error {
+	if r.boundErr != nil {
+		return validate.NewInternalError(r.boundErr)
+	}
+
+	value, isNil := validate.Indirect(value)
+	if isNil || validate.IsEmpty(value) {
+		return nil
+	}
+
+	str, err := validate.EnsureString(value)
+	if err != nil {
+		return err
+	}
+
+	if q, ok := ParseResourceQuantity(str); !ok || q < r.min || q > r.max {
+		return r.err
+	}
+	return nil
+}
+
+// Error sets the error message for the rule.
+func (r ResourceQuantityRangeRule) Error(message string) ResourceQuantityRangeRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r ResourceQuantityRangeRule) ErrorObject(err validate.Error) ResourceQuantityRangeRule {
+	r.err = err
+	return r
+}
+
+// ParseResourceQuantity parses a Kubernetes-style resource quantity, which is a decimal number followed by
+// an optional binary SI suffix (Ki, Mi, Gi, Ti, Pi, Ei), decimal SI suffix (n, u, m, k, M, G, T, P, E)
+// or decimal exponent (e.g. "1e3"). It returns the value of the quantity and whether the string is valid.
+func ParseResourceQuantity(value string) (float64, bool) {
+	m := reResourceQuantity.FindStringSubmatch(value)
+	if m == nil {
+		return 0, false
+	}
+	n, err := strconv.ParseFloat(m[1], 64)
+	if err != nil {
+		return 0, false
+	}
+	suffix := m[2]
+	if suffix == "" {
+		return n, true
+	}
+	if multiplier, ok := resourceQuantitySuffixes[suffix]; ok {
+		return n * multiplier, true
+	}
+	exp, err := strconv.Atoi(suffix[1:])
+	if err != nil {
+		return 0, false
+	}
+	return n * math.Pow10(exp), true
+}
+
+// resourceQuantitySuffixes are the multipliers of the binary and decimal SI suffixes of resource quantities.
+var resourceQuantitySuffixes = map[string]float64{
+	"Ki": 1 << 10, "Mi": 1 << 20, "Gi": 1 << 30, "Ti": 1 << 40, "Pi": 1 << 50, "Ei": 1 << 60,
+	"n": 1e-9, "u": 1e-6, "m": 1e-3, "k": 1e3, "M": 1e6, "G": 1e9, "T": 1e12, "P": 1e15, "E": 1e18,
+}
+
+func isResourceQuantity(value string) bool {
+	_, ok := ParseResourceQuantity(value)
+	return ok
+}
+
+func isSSN(value string) bool {
+	m := reSSN.FindStringSubmatch(value)
+	if m == nil {
+		return false
+	}
+	area, group, serial := m[1], m[2], m[3]
+	return area != "000" && area != "666" && area[0] != '9' && group != "00" && serial != "0000"
+}
+
+// vinWeights are the position weights used to compute the VIN check digit.
+var vinWeights = [17]int{8, 7, 6, 5, 4, 3, 2, 10, 0, 9, 8, 7, 6, 5, 4, 3, 2}
+
+func isVIN(value string) bool {
+	if len(value) != 17 {
+		return false
+	}
+	sum := 0
+	for i := 0; i < 17; i++ {
+		v := vinValue(value[i])
+		if v < 0 {
+			return false
+		}
+		sum += v * vinWeights[i]
+	}
+	check := byte('0' + sum%11)
+	if sum%11 == 10 {
+		check = 'X'
+	}
+	return value[8] == check || value[8] == 'x' && check == 'X'
+}
+
+func isEAN13(value string) bool {
+	digits, ok := barcodeDigits(value)
+	return ok && len(digits) == 13 && validGTINCheckDigit(digits)
+}
+
+func isUPCA(value string) bool {
+	digits, ok := barcodeDigits(value)
+	return ok && len(digits) == 12 && validGTINCheckDigit(digits)
+}
+
+func isGTIN(value string) bool {
+	digits, ok := barcodeDigits(value)
+	if !ok {
+		return false
+	}
+	switch len(digits) {
+	case 8, 12, 13, 14:
+		return validGTINCheckDigit(digits)
+	}
+	return false
+}
+
+// barcodeDigits returns the digits of a barcode with spaces removed.
+// It returns false if the barcode contains any other non-digit character.
+func barcodeDigits(value string) (string, bool) {
+	digits := strings.Replace(value, " ", "", -1)
+	return digits, reDigit.MatchString(digits)
+}
+
+// validGTINCheckDigit reports whether the last digit is the modulo-10 check digit of the preceding ones.
+// Digits are weighted 3 and 1 alternately, starting with 3 for the digit next to the check digit.
+func validGTINCheckDigit(digits string) bool {
+	n := len(digits)
+	sum := 0
+	for i := n - 2; i >= 0; i-- {
+		d := int(digits[i] - '0')
+		if (n-2-i)%2 == 0 {
+			d *= 3
+		}
+		sum += d
+	}
+	return int(digits[n-1]-'0') == (10-sum%10)%10
+}
+
+func isCreditCard(value string) bool {
+	digits := strings.NewReplacer(" ", "", "-", "").Replace(value)
+	return len(digits) >= 13 && len(digits) <= 19 && reDigit.MatchString(digits) && validLuhn(digits)
+}
+
+// validLuhn reports whether the digits pass the Luhn checksum.
+// Starting with the check digit, every second digit is doubled, subtracting 9 if the result exceeds 9.
+func validLuhn(digits string) bool {
+	sum := 0
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i] - '0')
+		if (len(digits)-1-i)%2 == 1 {
+			if d *= 2; d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	return sum%10 == 0
+}
+
+// vinValue returns the transliterated value of a VIN character, or -1 if the character is not allowed.
+// The letters I, O and Q are not allowed in a VIN.
+func vinValue(c byte) int {
+	if c >= 'a' && c <= 'z' {
+		c -= 'a' - 'A'
+	}
+	switch {
+	case c >= '0' && c <= '9':
+		return int(c - '0')
+	case c >= 'A' && c <= 'H':
+		return int(c-'A') + 1
+	case c >= 'J' && c <= 'N':
+		return int(c-'J') + 1
+	case c == 'P':
+		return 7
+	case c == 'R':
+		return 9
+	case c >= 'S' && c <= 'Z':
+		return int(c-'S') + 2
+	}
+	return -1
+}
+
 func isUTFNumeric(value string) bool {
 	for _, c := range value {
 		if unicode.IsNumber(c) == false {
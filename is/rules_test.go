@@ -5,8 +5,10 @@
 package is
 
 import (
+	"errors"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/nanoteck137/validate"
 	"github.com/stretchr/testify/assert"
@@ -22,6 +24,8 @@ func TestAll(t *testing.T) {
 		{"Email", Email, "test@example.com", "example.com", "must be a valid email address"},
 		{"EmailFormat", EmailFormat, "test@example.com", "example.com", "must be a valid email address"},
 		{"URL", URL, "http://example.com", "examplecom", "must be a valid URL"},
+		{"RelativeURL", RelativeURL, "/path?q=1#frag", "http://example.com/path", "must be a valid relative URL"},
+		{"RelativeURLWithHost", RelativeURLWithHost, "//example.com/path", "http://example.com/path", "must be a valid relative URL"},
 		{"RequestURL", RequestURL, "http://example.com", "examplecom", "must be a valid request URL"},
 		{"RequestURI", RequestURI, "http://example.com", "examplecom", "must be a valid request URI"},
 		{"Alpha", Alpha, "abcd", "ab12", "must contain English letters only"},
@@ -42,10 +46,37 @@ func TestAll(t *testing.T) {
 		{"Domain", Domain, "example-domain.com", strings.Repeat("a", 256), "must be a valid domain"},
 		{"DNSName", DNSName, "example.com", "abc%", "must be a valid DNS name"},
 		{"Host", Host, "example.com", "abc%", "must be a valid IP address or DNS name"},
+		{"HostPort", HostPort, "localhost:6379", "localhost", "must be a valid host:port"},
+		{"HostPort", HostPort, "10.0.0.1:80", "10.0.0.1:99999", "must be a valid host:port"},
+		{"HostPort", HostPort, "[::1]:8080", "::1:8080", "must be a valid host:port"},
+		{"HostPort", HostPort, "example.com:443", ":443", "must be a valid host:port"},
+		{"HostPort", HostPort, "example.com:443", "abc%:443", "must be a valid host:port"},
 		{"Port", Port, "123", "99999", "must be a valid port number"},
 		{"Latitude", Latitude, "23.123", "100", "must be a valid latitude"},
 		{"Longitude", Longitude, "123.123", "abc", "must be a valid longitude"},
-		{"SSN", SSN, "100-00-1000", "100-0001000", "must be a valid social security number"},
+		{"SSN", SSN, "100-01-1000", "100-0001000", "must be a valid social security number"},
+		{"SSN", SSN, "123456789", "000-12-3456", "must be a valid social security number"},
+		{"SSN", SSN, "123 45 6789", "666-12-3456", "must be a valid social security number"},
+		{"SSN", SSN, "899-12-3456", "900-12-3456", "must be a valid social security number"},
+		{"SSN", SSN, "123-45-6789", "123-00-6789", "must be a valid social security number"},
+		{"SSN", SSN, "123-45-6789", "123-45-0000", "must be a valid social security number"},
+		{"VIN", VIN, "1M8GDM9AXKP042788", "1M8GDM9AYKP042788", "must be a valid VIN"},
+		{"VIN", VIN, "11111111111111111", "1M8GDM9AXKP04278", "must be a valid VIN"},
+		{"VIN", VIN, "1m8gdm9axkp042788", "1M8GDM9AXKP04278I", "must be a valid VIN"},
+		{"ResourceQuantity", ResourceQuantity, "500m", "500 m", "must be a valid resource quantity"},
+		{"ResourceQuantity", ResourceQuantity, "2Gi", "2GB", "must be a valid resource quantity"},
+		{"ResourceQuantity", ResourceQuantity, "1.5", "1.5.1", "must be a valid resource quantity"},
+		{"ResourceQuantity", ResourceQuantity, "1e3", "1e", "must be a valid resource quantity"},
+		{"ResourceQuantity", ResourceQuantity, "4E", "Gi", "must be a valid resource quantity"},
+		{"EAN13", EAN13, "4006381333931", "4006381333932", "must be a valid barcode"},
+		{"EAN13", EAN13, "400 6381 33393 1", "036000291452", "must be a valid barcode"},
+		{"EAN13", EAN13, "4006381333931", "400-6381333931", "must be a valid barcode"},
+		{"UPCA", UPCA, "036000291452", "036000291453", "must be a valid barcode"},
+		{"UPCA", UPCA, "0 36000 29145 2", "4006381333931", "must be a valid barcode"},
+		{"GTIN", GTIN, "96385074", "96385075", "must be a valid barcode"},
+		{"GTIN", GTIN, "036000291452", "03600029145", "must be a valid barcode"},
+		{"GTIN", GTIN, "4006381333931", "400638133393a", "must be a valid barcode"},
+		{"GTIN", GTIN, "10012345678902", "10012345678903", "must be a valid barcode"},
 		{"Semver", Semver, "1.0.0", "1.0.0.0", "must be a valid semantic version"},
 		{"ISBN", ISBN, "1-61729-085-8", "1-61729-085-81", "must be a valid ISBN"},
 		{"ISBN10", ISBN10, "1-61729-085-8", "1-61729-085-81", "must be a valid ISBN-10"},
@@ -56,16 +87,17 @@ func TestAll(t *testing.T) {
 		{"UUIDv5", UUIDv5, "987fbc97-4bed-5078-af07-9141ba07c9f3", "b987fbc9-4bed-3078-cf07-9141ba07c9f3", "must be a valid UUID v5"},
 		{"MongoID", MongoID, "507f1f77bcf86cd799439011", "507f1f77bcf86cd79943901", "must be a valid hex-encoded MongoDB ObjectId"},
 		{"CreditCard", CreditCard, "375556917985515", "375556917985516", "must be a valid credit card number"},
-		{"JSON", JSON, "[1, 2]", "[1, 2,]", "must be in valid JSON format"},
+		{"JSON", JSON, "[1, 2]", "[1, 2,]", "must be a valid JSON string"},
 		{"ASCII", ASCII, "abc", "ａabc", "must contain ASCII characters only"},
 		{"PrintableASCII", PrintableASCII, "abc", "ａabc", "must contain printable ASCII characters only"},
-		{"E164", E164, "+19251232233", "+00124222333", "must be a valid E164 number"},
+		{"E164", E164, "+19251232233", "+00124222333", "must be a valid E.164 phone number"},
 		{"CountryCode2", CountryCode2, "US", "XY", "must be a valid two-letter country code"},
 		{"CountryCode3", CountryCode3, "USA", "XYZ", "must be a valid three-letter country code"},
 		{"CurrencyCode", CurrencyCode, "USD", "USS", "must be valid ISO 4217 currency code"},
 		{"DialString", DialString, "localhost.local:1", "localhost.loc:100000", "must be a valid dial string"},
 		{"DataURI", DataURI, "data:image/png;base64,TG9yZW0gaXBzdW0gZG9sb3Igc2l0IGFtZXQsIGNvbnNlY3RldHVyIGFkaXBpc2NpbmcgZWxpdC4=", "image/gif;base64,U3VzcGVuZGlzc2UgbGVjdHVzIGxlbw==", "must be a Base64-encoded data URI"},
 		{"Base64", Base64, "TG9yZW0gaXBzdW0gZG9sb3Igc2l0IGFtZXQsIGNvbnNlY3RldHVyIGFkaXBpc2NpbmcgZWxpdC4=", "image", "must be encoded in Base64"},
+		{"Base64URL", Base64URL, "PDw_Pz4-", "PDw/Pz4+", "must be encoded in Base64 URL format"},
 		{"Multibyte", Multibyte, "ａｂｃ", "abc", "must contain multibyte characters"},
 		{"FullWidth", FullWidth, "３ー０", "abc", "must contain full-width characters"},
 		{"HalfWidth", HalfWidth, "abc123い", "００１１", "must contain half-width characters"},
@@ -99,3 +131,358 @@ func assertError(t *testing.T, expected string, err error, tag string) {
 		assert.Equal(t, expected, err.Error(), tag)
 	}
 }
+
+func TestRelativeURL(t *testing.T) {
+	tests := []struct {
+		tag   string
+		value string
+		valid bool
+		host  bool
+	}{
+		{"t1", "/path?q=1#frag", true, true},
+		{"t2", "path/to/file.html", true, true},
+		{"t3", "../up", true, true},
+		{"t4", "?q=1", true, true},
+		{"t5", "#frag", true, true},
+		{"t6", "/a%20b", true, true},
+		{"t7", "//example.com/path", false, true},
+		{"t8", "http://example.com/path", false, false},
+		{"t9", "mailto:a@example.com", false, false},
+		{"t10", "a:b", false, false},
+		{"t11", "/a b", false, false},
+		{"t12", "/%zz", false, false},
+		{"t13", "//[::1", false, false},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.valid, RelativeURL.Validate(test.value) == nil, test.tag)
+		assert.Equal(t, test.host, RelativeURLWithHost.Validate(test.value) == nil, test.tag)
+	}
+}
+
+func TestQueryString(t *testing.T) {
+	tests := []struct {
+		tag   string
+		rule  QueryStringRule
+		value interface{}
+		err   string
+	}{
+		{"t1", QueryString, "", ""},
+		{"t2", QueryString, "a=1&b=x%20y&b=z", ""},
+		{"t3", QueryString, "flag&a=", ""},
+		{"t4", QueryString, []byte("a=1"), ""},
+		{"t5", QueryString, "a=%zz", "must be a valid query string"},
+		{"t6", QueryString, "a=1;b=2", "must be a valid query string"},
+		{"t7", QueryString, "=1", "must be a valid query string"},
+		{"t8", QueryString, 123, "must be either a string or byte slice"},
+		{"t9", QueryString.AllowedParams("a", "b"), "a=1&b=2", ""},
+		{"t10", QueryString.AllowedParams("a", "b"), "a=1&d=2&c=3", "query parameter c is not allowed"},
+		{"t11", QueryString.AllowedParams(), "a=1", "query parameter a is not allowed"},
+		{"t12", QueryString.AllowedParams("a"), "a=%zz", "must be a valid query string"},
+	}
+
+	for _, test := range tests {
+		err := test.rule.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	r := QueryString.AllowedParams("a").Error("bad query").ParamError("unknown parameter {{.param}}")
+	assert.EqualError(t, r.Validate("%"), "bad query")
+	assert.EqualError(t, r.Validate("b=1"), "unknown parameter b")
+
+	err := validate.NewError("code", "abc")
+	assert.Equal(t, err, QueryString.ErrorObject(err).err)
+	assert.Equal(t, err, QueryString.ParamErrorObject(err).paramErr)
+}
+
+func TestBase64(t *testing.T) {
+	tests := []struct {
+		tag   string
+		value string
+		std   bool
+		url   bool
+	}{
+		{"t1", "", true, true},
+		{"t2", "YWJj", true, true},
+		{"t3", "YWJjZA==", true, true},
+		{"t4", "YWJjZA", false, true},
+		{"t5", "YWJjZA=", false, false},
+		{"t6", "PDw/Pz4+", true, false},
+		{"t7", "PDw_Pz4-", false, true},
+		{"t8", "PDw_Pz4+", false, false},
+		{"t9", "YWJj ZA==", false, false},
+		{"t10", "YWJj\nZA==", false, false},
+		{"t11", " YWJj", false, false},
+		{"t12", "Y", false, false},
+		{"t13", "YWJj!", false, false},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.std, Base64.Validate(test.value) == nil, test.tag)
+		assert.Equal(t, test.url, Base64URL.Validate(test.value) == nil, test.tag)
+	}
+}
+
+func TestTimezone(t *testing.T) {
+	tests := []struct {
+		tag   string
+		value string
+		valid bool
+	}{
+		{"t1", "", true},
+		{"t2", "UTC", true},
+		{"t3", "America/New_York", true},
+		{"t4", "Europe/Berlin", true},
+		{"t5", "Local", false},
+		{"t6", "America/Nowhere", false},
+		{"t7", "New York", false},
+		{"t8", "../etc/passwd", false},
+	}
+
+	defer func() { LoadLocation = time.LoadLocation }()
+	LoadLocation = func(name string) (*time.Location, error) {
+		switch name {
+		case "UTC", "America/New_York", "Europe/Berlin":
+			return time.FixedZone(name, 0), nil
+		}
+		return nil, errors.New("unknown time zone " + name)
+	}
+
+	for _, test := range tests {
+		err := Timezone.Validate(test.value)
+		if test.valid {
+			assert.Nil(t, err, test.tag)
+		} else {
+			assert.EqualError(t, err, "must be a valid timezone", test.tag)
+		}
+	}
+
+	// the loader is used on each validation
+	LoadLocation = func(string) (*time.Location, error) { return time.UTC, nil }
+	assert.Nil(t, Timezone.Validate("America/Nowhere"))
+
+	// time.LoadLocation knows UTC without the time zone database of the system
+	LoadLocation = time.LoadLocation
+	assert.Nil(t, Timezone.Validate("UTC"))
+	assert.NotNil(t, Timezone.Validate("Not/AZone"))
+}
+
+func TestCase(t *testing.T) {
+	upper := "ABC"
+	var nilStr *string
+	tests := []struct {
+		tag   string
+		value interface{}
+		upper string
+		lower string
+	}{
+		{"t1", "", "", ""},
+		{"t2", "ABC", "", "must be in lower case"},
+		{"t3", "abc", "must be in upper case", ""},
+		{"t4", "Abc", "must be in upper case", "must be in lower case"},
+		{"t5", "12345", "", ""},
+		{"t6", "ABC-123 !", "", "must be in lower case"},
+		{"t7", "abc-123 !", "must be in upper case", ""},
+		{"t8", "ÄÖÜ", "", "must be in lower case"},
+		{"t9", "äöü", "must be in upper case", ""},
+		{"t10", "ΑΒΓ", "", "must be in lower case"},
+		{"t11", "αβΓ", "must be in upper case", "must be in lower case"},
+		{"t12", "日本語", "", ""},
+		{"t13", []byte("ABC"), "", "must be in lower case"},
+		{"t14", &upper, "", "must be in lower case"},
+		{"t15", nilStr, "", ""},
+	}
+
+	for _, test := range tests {
+		for _, c := range []struct {
+			rule validate.Rule
+			err  string
+		}{{UpperCase, test.upper}, {LowerCase, test.lower}} {
+			err := c.rule.Validate(test.value)
+			if c.err == "" {
+				assert.Nil(t, err, test.tag)
+			} else {
+				assert.EqualError(t, err, c.err, test.tag)
+			}
+		}
+	}
+}
+
+func TestCreditCard(t *testing.T) {
+	tests := []struct {
+		tag   string
+		value string
+		valid bool
+	}{
+		{"t1", "", true},
+		{"t2", "4111111111111111", true},
+		{"t3", "5500000000000004", true},
+		{"t4", "378282246310005", true},
+		{"t5", "6011111111111117", true},
+		{"t6", "4222222222222", true},
+		{"t7", "4111 1111 1111 1111", true},
+		{"t8", "4111-1111-1111-1111", true},
+		{"t9", "6200000000000000000", true},
+		{"t10", "4111111111111112", false},
+		{"t11", "4111a1111111111", false},
+		{"t12", "4111 1111 1111 111x", false},
+		{"t13", "411111111117", false},
+		{"t14", "41111111111111111115", false},
+		{"t15", "4111.1111.1111.1111", false},
+		{"t16", " - ", false},
+	}
+
+	for _, test := range tests {
+		err := CreditCard.Validate(test.value)
+		if test.valid {
+			assert.Nil(t, err, test.tag)
+		} else {
+			assert.EqualError(t, err, "must be a valid credit card number", test.tag)
+		}
+	}
+}
+
+func TestE164(t *testing.T) {
+	tests := []struct {
+		tag   string
+		value string
+		valid bool
+	}{
+		{"t1", "", true},
+		{"t2", "+14155552671", true},
+		{"t3", "+442071838750", true},
+		{"t4", "+123456789012345", true},
+		{"t5", "+12", true},
+		{"t6", "+0123", false},
+		{"t7", "14155552671", false},
+		{"t8", "+1234567890123456", false},
+		{"t9", "+1 415 555 2671", false},
+		{"t10", "+1-415-555-2671", false},
+		{"t11", "+1(415)5552671", false},
+		{"t12", "+", false},
+		{"t13", "+1", false},
+		{"t14", "++14155552671", false},
+		{"t15", "+14155552671 ", false},
+	}
+
+	for _, test := range tests {
+		err := E164.Validate(test.value)
+		if test.valid {
+			assert.Nil(t, err, test.tag)
+		} else {
+			assert.EqualError(t, err, "must be a valid E.164 phone number", test.tag)
+		}
+	}
+}
+
+func TestSemver(t *testing.T) {
+	tests := []struct {
+		tag   string
+		value string
+		valid bool
+	}{
+		{"t1", "", true},
+		{"t2", "1.2.3", true},
+		{"t3", "0.0.0", true},
+		{"t4", "10.20.30", true},
+		{"t5", "1.0.0-alpha", true},
+		{"t6", "1.0.0-alpha.1", true},
+		{"t7", "1.0.0-0.3.7", true},
+		{"t8", "1.0.0-x-y-z.--", true},
+		{"t9", "2.0.0-rc.1+build", true},
+		{"t10", "1.0.0+20130313144700", true},
+		{"t11", "1.0.0-beta+exp.sha.5114f85", true},
+		{"t12", "1.0.0-alpha.0valid", true},
+		{"t13", "v1.2.3", false},
+		{"t14", "v1.2", false},
+		{"t15", "1.0", false},
+		{"t16", "1.0.0.0", false},
+		{"t17", "01.2.3", false},
+		{"t18", "1.02.3", false},
+		{"t19", "1.2.03", false},
+		{"t20", "1.0.0-01", false},
+		{"t21", "1.0.0-alpha..1", false},
+		{"t22", "1.0.0+", false},
+		{"t23", "1.0.0-", false},
+		{"t24", "1.0.0-alpha_beta", false},
+		{"t25", " 1.0.0", false},
+	}
+
+	for _, test := range tests {
+		err := Semver.Validate(test.value)
+		if test.valid {
+			assert.Nil(t, err, test.tag)
+		} else {
+			assert.EqualError(t, err, "must be a valid semantic version", test.tag)
+		}
+	}
+}
+
+func TestJSON(t *testing.T) {
+	var nilPtr *string
+	doc := `{"a": 1}`
+
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", "", ""},
+		{"t2", nilPtr, ""},
+		{"t3", `{"a": [1, 2], "b": {"c": null}}`, ""},
+		{"t4", `[1, "a", true]`, ""},
+		{"t5", `"abc"`, ""},
+		{"t6", "12.5", ""},
+		{"t7", "null", ""},
+		{"t8", &doc, ""},
+		{"t9", []byte(`{"a": 1}`), ""},
+		{"t10", `{"a": 1}}`, "must be a valid JSON string"},
+		{"t11", `{"a": 1} x`, "must be a valid JSON string"},
+		{"t12", `[1, 2] [3]`, "must be a valid JSON string"},
+		{"t13", "abc", "must be a valid JSON string"},
+		{"t14", `{'a': 1}`, "must be a valid JSON string"},
+	}
+
+	for _, test := range tests {
+		err := JSON.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	for _, value := range []interface{}{123, map[string]interface{}{"a": 1}} {
+		err := JSON.Validate(value)
+		if assert.NotNil(t, err) {
+			_, ok := err.(validate.InternalError)
+			assert.True(t, ok)
+		}
+	}
+
+	r := JSON.Error("invalid document")
+	assert.EqualError(t, r.Validate("{"), "invalid document")
+	err := validate.NewError("code", "abc")
+	assert.Equal(t, err, JSON.ErrorObject(err).err)
+}
+
+func TestResourceQuantityBetween(t *testing.T) {
+	r := ResourceQuantityBetween("100m", "2")
+	assert.Nil(t, r.Validate("100m"))
+	assert.Nil(t, r.Validate("1.5"))
+	assert.Nil(t, r.Validate("2000m"))
+	assert.EqualError(t, r.Validate("50m"), "must be a valid resource quantity between 100m and 2")
+	assert.EqualError(t, r.Validate("3"), "must be a valid resource quantity between 100m and 2")
+	assert.EqualError(t, r.Validate("abc"), "must be a valid resource quantity between 100m and 2")
+
+	r = ResourceQuantityBetween("64Mi", "1Gi")
+	assert.Nil(t, r.Validate("512Mi"))
+	assert.Nil(t, r.Validate("1073741824"))
+	assert.NotNil(t, r.Validate("2G"))
+
+	assert.Nil(t, r.Validate(""))
+	assert.EqualError(t, r.Error("out of range").Validate("2G"), "out of range")
+
+	for _, r := range []ResourceQuantityRangeRule{ResourceQuantityBetween("abc", "1"), ResourceQuantityBetween("1", "abc")} {
+		err := r.Validate("500m")
+		_, ok := err.(validate.InternalError)
+		assert.True(t, ok, err)
+	}
+	assert.EqualError(t, ResourceQuantityBetween("1", "abc").Validate("500m"), `invalid maximum resource quantity "abc"`)
+}
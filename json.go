@@ -0,0 +1,41 @@
+package validate
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+)
+
+// ErrJSONUnknownField is the error that returns when a JSON document contains a field unknown to the target struct.
+var ErrJSONUnknownField = NewError("validation_json_unknown_field", "unknown field '{{.field}}'")
+
+// StrictJSON decodes the raw JSON document into target while rejecting any key that does not
+// correspond to a field of the target struct. This catches misspelled fields that would otherwise
+// be silently ignored by encoding/json.
+//
+// If an unknown key is found, an Error carrying the name of the offending key in the "field"
+// parameter is returned. An error is also returned if the document has data after the first JSON value.
+// Any other decoding error is returned as is.
+// Use Validate() on the decoded target to check the field values afterwards.
+func StrictJSON(raw []byte, target interface{}) error {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.DisallowUnknownFields()
+
+	err := dec.Decode(target)
+	if err == nil {
+		var trailing json.RawMessage
+		if dec.Decode(&trailing) != io.EOF {
+			return errors.New("json: unexpected data after the top-level value")
+		}
+		return nil
+	}
+
+	const prefix = "json: unknown field "
+	if msg := err.Error(); strings.HasPrefix(msg, prefix) {
+		field := strings.Trim(strings.TrimPrefix(msg, prefix), `"`)
+		return ErrJSONUnknownField.SetParams(map[string]interface{}{"field": field})
+	}
+	return err
+}
@@ -0,0 +1,41 @@
+package validate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStrictJSON(t *testing.T) {
+	type config struct {
+		Host string `json:"host"`
+		Port int    `json:"port"`
+	}
+
+	tests := []struct {
+		tag string
+		raw string
+		err string
+	}{
+		{"t1", `{"host":"localhost","port":8080}`, ""},
+		{"t2", `{"host":"localhost"}`, ""},
+		{"t3", `{"host":"localhost","porrt":8080}`, "unknown field 'porrt'"},
+		{"t4", `{"host":"localhost",`, "unexpected EOF"},
+		{"t5", `{"host":"localhost"}{"port":8080}`, "json: unexpected data after the top-level value"},
+		{"t6", `{"host":"localhost"} x`, "json: unexpected data after the top-level value"},
+		{"t7", "{\"host\":\"localhost\"}\n", ""},
+	}
+
+	for _, test := range tests {
+		var c config
+		err := StrictJSON([]byte(test.raw), &c)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	var c config
+	err := StrictJSON([]byte(`{"porrt":8080}`), &c)
+	if assert.Implements(t, (*Error)(nil), err) {
+		assert.Equal(t, "validation_json_unknown_field", err.(Error).Code())
+		assert.Equal(t, "porrt", err.(Error).Params()["field"])
+	}
+}
@@ -0,0 +1,81 @@
+package validate
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// ErrJSONType is the error that returns when a decoded JSON value is not of the expected type.
+var ErrJSONType = NewError("validation_json_type", "must be a JSON {{.kind}}")
+
+// JSONType returns a validation rule that checks if a decoded JSON value, as produced by json.Unmarshal into
+// an interface{}, is of the given kind regardless of its contents. The kind is one of "object", "array",
+// "string", "number", "bool" or "null". Maps with string keys are objects, slices and arrays are arrays,
+// and json.Number values as well as all int, uint and float values are numbers.
+// If the kind is unknown, the rule returns an internal error.
+// A nil value is considered valid unless the kind is "null", in which case only nil is valid.
+// Use the Required or NotNil rule to make sure a value is present.
+func JSONType(kind string) JSONTypeRule {
+	return JSONTypeRule{
+		kind: kind,
+		err:  ErrJSONType,
+	}
+}
+
+// JSONTypeRule is a validation rule that checks the type of a decoded JSON value.
+type JSONTypeRule struct {
+	kind string
+	err  Error
+}
+
+// Validate checks if the given value is valid or not.
+func (r JSONTypeRule) Validate(value interface{}) error {
+	var matches func(v reflect.Value) bool
+	switch r.kind {
+	case "object":
+		matches = func(v reflect.Value) bool { return v.Kind() == reflect.Map && v.Type().Key().Kind() == reflect.String }
+	case "array":
+		matches = func(v reflect.Value) bool { return v.Kind() == reflect.Slice || v.Kind() == reflect.Array }
+	case "string":
+		matches = func(v reflect.Value) bool { return v.Kind() == reflect.String && v.Type() != jsonNumberType }
+	case "number":
+		matches = func(v reflect.Value) bool {
+			if v.Type() == jsonNumberType {
+				return true
+			}
+			_, err := ToNumber(v.Interface())
+			return err == nil
+		}
+	case "bool":
+		matches = func(v reflect.Value) bool { return v.Kind() == reflect.Bool }
+	case "null":
+		matches = func(reflect.Value) bool { return false }
+	default:
+		return NewInternalError(fmt.Errorf("unknown JSON type %q", r.kind))
+	}
+
+	value, isNil := Indirect(value)
+	if isNil {
+		return nil
+	}
+	if !matches(reflect.ValueOf(value)) {
+		return r.err.SetParams(map[string]interface{}{"kind": r.kind})
+	}
+	return nil
+}
+
+// jsonNumberType is the type of json.Number, which is decoded from JSON numbers when UseNumber is enabled.
+var jsonNumberType = reflect.TypeOf(json.Number(""))
+
+// Error sets the error message for the rule.
+func (r JSONTypeRule) Error(message string) JSONTypeRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r JSONTypeRule) ErrorObject(err Error) JSONTypeRule {
+	r.err = err
+	return r
+}
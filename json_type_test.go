@@ -0,0 +1,66 @@
+package validate
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJSONType(t *testing.T) {
+	decode := func(s string) interface{} {
+		var v interface{}
+		if err := json.Unmarshal([]byte(s), &v); err != nil {
+			panic(err)
+		}
+		return v
+	}
+
+	tests := []struct {
+		tag   string
+		kind  string
+		value interface{}
+		err   string
+	}{
+		{"t1", "array", decode(`[1, "a"]`), ""},
+		{"t2", "array", decode(`[]`), ""},
+		{"t3", "array", decode(`{}`), "must be a JSON array"},
+		{"t4", "array", nil, ""},
+		{"t5", "object", decode(`{"a": 1}`), ""},
+		{"t6", "object", decode(`"a"`), "must be a JSON object"},
+		{"t7", "object", map[int]string{1: "a"}, "must be a JSON object"},
+		{"t8", "string", decode(`""`), ""},
+		{"t9", "string", decode(`1`), "must be a JSON string"},
+		{"t10", "string", json.Number("1"), "must be a JSON string"},
+		{"t11", "number", decode(`1.5`), ""},
+		{"t12", "number", decode(`0`), ""},
+		{"t13", "number", json.Number("1"), ""},
+		{"t14", "number", 3, ""},
+		{"t15", "number", decode(`"1"`), "must be a JSON number"},
+		{"t16", "bool", decode(`false`), ""},
+		{"t17", "bool", decode(`0`), "must be a JSON bool"},
+		{"t18", "null", decode(`null`), ""},
+		{"t19", "null", decode(`0`), "must be a JSON null"},
+		{"t20", "tuple", decode(`[]`), "unknown JSON type \"tuple\""},
+	}
+
+	for _, test := range tests {
+		err := JSONType(test.kind).Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestJSONTypeRule_Error(t *testing.T) {
+	r := JSONType("array").Error("expected a list")
+	assert.Equal(t, "expected a list", r.Validate("a").Error())
+}
+
+func TestJSONTypeRule_ErrorObject(t *testing.T) {
+	r := JSONType("array")
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
@@ -0,0 +1,74 @@
+package validate
+
+import (
+	"errors"
+	"reflect"
+	"regexp"
+	"sort"
+)
+
+// ErrKeyPattern is the error that returns when a map key does not match the required pattern.
+var ErrKeyPattern = NewError("validation_key_pattern", "key '{{.key}}' is not a valid identifier")
+
+// KeyPattern returns a validation rule that checks if every key of a map with string keys matches the given
+// regular expression, e.g. to make sure the keys of a configuration map are safe to use as environment
+// variable names:
+//    validation.KeyPattern(regexp.MustCompile(`^[A-Z_][A-Z0-9_]*$`))
+//
+// Keys are checked in sorted order and the first offending key is reported in the "key" parameter of the error.
+// Note that, as for the Match rule, the regular expression should be anchored to match whole keys.
+// Validating a value that is not a map with string keys results in an internal error.
+// An empty map is considered valid. Use the Required rule to make sure a map is not empty.
+func KeyPattern(re *regexp.Regexp) KeyPatternRule {
+	return KeyPatternRule{
+		re:  re,
+		err: ErrKeyPattern,
+	}
+}
+
+// KeyPatternRule is a validation rule that checks if the keys of a map match a regular expression.
+type KeyPatternRule struct {
+	re  *regexp.Regexp
+	err Error
+}
+
+// Validate checks if the given value is valid or not.
+func (r KeyPatternRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Map {
+		return NewInternalError(ErrNotMap)
+	}
+	if v.Type().Key().Kind() != reflect.String {
+		return NewInternalError(errors.New("map keys must be strings"))
+	}
+
+	keys := make([]string, 0, v.Len())
+	for _, k := range v.MapKeys() {
+		keys = append(keys, k.String())
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if !r.re.MatchString(key) {
+			return r.err.SetParams(map[string]interface{}{"key": key})
+		}
+	}
+	return nil
+}
+
+// Error sets the error message for the rule.
+func (r KeyPatternRule) Error(message string) KeyPatternRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r KeyPatternRule) ErrorObject(err Error) KeyPatternRule {
+	r.err = err
+	return r
+}
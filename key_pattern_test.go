@@ -0,0 +1,54 @@
+package validate
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyPattern(t *testing.T) {
+	type envName string
+	var nilMap map[string]string
+
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", nilMap, ""},
+		{"t2", map[string]string{}, ""},
+		{"t3", map[string]string{"HOME": "/root", "GO_PATH": ""}, ""},
+		{"t4", map[string]string{"HOME": "/root", "my-key": "x"}, "key 'my-key' is not a valid identifier"},
+		{"t5", map[string]int{"b-b": 1, "a-a": 2, "OK": 3}, "key 'a-a' is not a valid identifier"},
+		{"t6", map[envName]bool{"PATH": true}, ""},
+		{"t7", &map[string]string{"1X": ""}, "key '1X' is not a valid identifier"},
+		{"t8", map[int]string{1: "a"}, "map keys must be strings"},
+		{"t9", []string{"a"}, "only a map can be validated"},
+	}
+
+	for _, test := range tests {
+		err := KeyPattern(regexp.MustCompile(`^[A-Z_][A-Z0-9_]*$`)).Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	for _, value := range []interface{}{map[int]string{1: "a"}, []string{"a"}} {
+		_, ok := KeyPattern(regexp.MustCompile(`^[a-z]+$`)).Validate(value).(InternalError)
+		assert.True(t, ok, "%v", value)
+	}
+}
+
+func TestKeyPatternRule_Error(t *testing.T) {
+	r := KeyPattern(regexp.MustCompile(`^[a-z]+$`)).Error("invalid key {{.key}}")
+	assert.Equal(t, "invalid key A", r.Validate(map[string]int{"A": 1}).Error())
+}
+
+func TestKeyPatternRule_ErrorObject(t *testing.T) {
+	r := KeyPattern(nil)
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
@@ -23,12 +23,33 @@ var (
 
 // Length returns a validation rule that checks if a value's length is within the specified range.
 // If max is 0, it means there is no upper bound for the length.
+// The length of a string is its number of bytes. Call Runes to count runes instead.
 // This rule should only be used for validating strings, slices, maps, and arrays.
 // An empty value is considered valid. Use the Required rule to make sure a value is not empty.
 func Length(min, max int) LengthRule {
 	return LengthRule{min: min, max: max, err: buildLengthRuleError(min, max)}
 }
 
+// MinLength returns a validation rule that checks if a value's length is at least min.
+// Unlike Length, there is no upper bound, so MinLength(0) accepts any value.
+// This rule should only be used for validating strings, slices, maps, and arrays.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func MinLength(min int) LengthRule {
+	return LengthRule{
+		min:   min,
+		noMax: true,
+		err:   ErrLengthTooShort.SetParams(map[string]interface{}{"min": min, "max": 0}),
+	}
+}
+
+// MaxLength returns a validation rule that checks if a value's length is at most max.
+// MaxLength(0) requires the value to be empty.
+// This rule should only be used for validating strings, slices, maps, and arrays.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func MaxLength(max int) LengthRule {
+	return Length(0, max)
+}
+
 // RuneLength returns a validation rule that checks if a string's rune length is within the specified range.
 // If max is 0, it means there is no upper bound for the length.
 // This rule should only be used for validating strings, slices, maps, and arrays.
@@ -47,6 +68,21 @@ type LengthRule struct {
 
 	min, max int
 	rune     bool
+	noMax    bool
+}
+
+// Bytes makes the rule count the bytes of a string, e.g. for byte-limited database columns.
+// The length of slices, maps and arrays is always their number of elements.
+func (r LengthRule) Bytes() LengthRule {
+	r.rune = false
+	return r
+}
+
+// Runes makes the rule count the runes of a string instead of its bytes.
+// The length of slices, maps and arrays is always their number of elements.
+func (r LengthRule) Runes() LengthRule {
+	r.rune = true
+	return r
 }
 
 // Validate checks if the given value is valid or not.
@@ -66,7 +102,7 @@ func (r LengthRule) Validate(value interface{}) error {
 		return err
 	}
 
-	if r.min > 0 && l < r.min || r.max > 0 && l > r.max || r.min == 0 && r.max == 0 && l > 0 {
+	if r.min > 0 && l < r.min || r.max > 0 && l > r.max || r.min == 0 && r.max == 0 && !r.noMax && l > 0 {
 		return r.err
 	}
 
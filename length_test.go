@@ -44,6 +44,63 @@ func TestLength(t *testing.T) {
 	}
 }
 
+func TestMinLength(t *testing.T) {
+	var v *string
+	tests := []struct {
+		tag   string
+		min   int
+		value interface{}
+		err   string
+	}{
+		{"t1", 2, "ab", ""},
+		{"t2", 2, "a", "the length must be no less than 2"},
+		{"t3", 2, "", ""},
+		{"t4", 2, v, ""},
+		{"t5", 2, []int{1, 2, 3}, ""},
+		{"t6", 2, []int{1}, "the length must be no less than 2"},
+		{"t7", 2, map[string]int{"a": 1}, "the length must be no less than 2"},
+		{"t8", 2, map[string]int{"a": 1, "b": 2}, ""},
+		{"t9", 0, "abc", ""},
+		{"t10", 0, []int{1}, ""},
+		{"t11", 0, map[string]int{"a": 1}, ""},
+		{"t12", 2, 123, "cannot get the length of int"},
+	}
+
+	for _, test := range tests {
+		err := MinLength(test.min).Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	assert.EqualError(t, MinLength(3).Runes().Validate("ab"), "the length must be no less than 3")
+	assert.Nil(t, MinLength(3).Validate("äb"))
+}
+
+func TestMaxLength(t *testing.T) {
+	tests := []struct {
+		tag   string
+		max   int
+		value interface{}
+		err   string
+	}{
+		{"t1", 2, "ab", ""},
+		{"t2", 2, "abc", "the length must be no more than 2"},
+		{"t3", 2, "", ""},
+		{"t4", 2, []int{1, 2, 3}, "the length must be no more than 2"},
+		{"t5", 2, [2]int{1, 2}, ""},
+		{"t6", 2, map[string]int{"a": 1, "b": 2, "c": 3}, "the length must be no more than 2"},
+		{"t7", 0, "a", "the value must be empty"},
+		{"t8", 0, "", ""},
+	}
+
+	for _, test := range tests {
+		err := MaxLength(test.max).Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	assert.Nil(t, MaxLength(2).Runes().Validate("äö"))
+	assert.EqualError(t, MaxLength(2).Validate("äö"), "the length must be no more than 2")
+}
+
 func TestRuneLength(t *testing.T) {
 	var v *string
 	tests := []struct {
@@ -79,6 +136,30 @@ func TestRuneLength(t *testing.T) {
 	}
 }
 
+func TestLengthRule_Mode(t *testing.T) {
+	tests := []struct {
+		tag   string
+		rule  LengthRule
+		value interface{}
+		err   string
+	}{
+		{"t1", Length(2, 4).Bytes(), "héé", "the length must be between 2 and 4"},
+		{"t2", Length(2, 4).Runes(), "héé", ""},
+		{"t3", RuneLength(2, 4).Bytes(), "héé", "the length must be between 2 and 4"},
+		{"t4", RuneLength(2, 4), "héé", ""},
+		{"t5", Length(0, 3).Bytes(), "日本", "the length must be no more than 3"},
+		{"t6", Length(0, 3).Runes(), "日本", ""},
+		{"t7", Length(2, 4).Bytes(), []string{"日本", "日本"}, ""},
+		{"t8", Length(2, 4).Runes(), []string{"日本", "日本"}, ""},
+		{"t9", Length(2, 4).Runes(), map[string]int{"日本": 1}, "the length must be between 2 and 4"},
+	}
+
+	for _, test := range tests {
+		err := test.rule.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
 func Test_LengthRule_Error(t *testing.T) {
 	r := Length(10, 20)
 	assert.Equal(t, "the length must be between 10 and 20", r.Validate("abc").Error())
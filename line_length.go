@@ -0,0 +1,58 @@
+package validate
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// ErrLineTooLong is the error that returns when a line of a string is too long.
+var ErrLineTooLong = NewError("validation_line_too_long", "line {{.line}} exceeds {{.max}} characters")
+
+// MaxLineLength returns a validation rule that checks if every line of a string is at most max runes long.
+// Lines are separated by "\n" and a trailing "\r" is not counted. The first offending line is reported
+// in the "line" parameter of the error, counting from 1.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func MaxLineLength(max int) LineLengthRule {
+	return LineLengthRule{
+		max: max,
+		err: ErrLineTooLong,
+	}
+}
+
+// LineLengthRule is a validation rule that checks the length of each line of a string.
+type LineLengthRule struct {
+	max int
+	err Error
+}
+
+// Validate checks if the given value is valid or not.
+func (r LineLengthRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	str, err := EnsureString(value)
+	if err != nil {
+		return err
+	}
+
+	for i, line := range strings.Split(str, "\n") {
+		if utf8.RuneCountInString(strings.TrimSuffix(line, "\r")) > r.max {
+			return r.err.SetParams(map[string]interface{}{"line": i + 1, "max": r.max})
+		}
+	}
+	return nil
+}
+
+// Error sets the error message for the rule.
+func (r LineLengthRule) Error(message string) LineLengthRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r LineLengthRule) ErrorObject(err Error) LineLengthRule {
+	r.err = err
+	return r
+}
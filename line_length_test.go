@@ -0,0 +1,48 @@
+package validate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMaxLineLength(t *testing.T) {
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", "", ""},
+		{"t2", "abcde", ""},
+		{"t3", "abcdef", "line 1 exceeds 5 characters"},
+		{"t4", "abc\nabcde\n", ""},
+		{"t5", "abc\n\nabcdef", "line 3 exceeds 5 characters"},
+		{"t6", "abcde\r\nabc", ""},
+		{"t7", "héllo\nwörld", ""},
+		{"t8", []byte("abc\nabcdefg"), "line 2 exceeds 5 characters"},
+		{"t9", 123, "must be either a string or byte slice"},
+	}
+
+	for _, test := range tests {
+		err := MaxLineLength(5).Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestLineLengthRule_Error(t *testing.T) {
+	r := MaxLineLength(72)
+	assert.Equal(t, "line 1 exceeds 72 characters", r.Validate(string(make([]byte, 73))).Error())
+
+	r = r.Error("line {{.line}} is too long")
+	assert.Equal(t, "line 2 is too long", r.Validate("a\n"+string(make([]byte, 73))).Error())
+}
+
+func TestLineLengthRule_ErrorObject(t *testing.T) {
+	r := MaxLineLength(72)
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
@@ -0,0 +1,126 @@
+package validate
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrLocalizedDateInvalid is the error that returns when a value is not a valid date for a locale.
+	ErrLocalizedDateInvalid = NewError("validation_localized_date_invalid", "must be a valid date for locale {{.locale}}")
+	// ErrLocalizedDateOutOfRange is the error that returns when a localized date is out of range.
+	ErrLocalizedDateOutOfRange = NewError("validation_localized_date_out_of_range", "the date is out of range")
+)
+
+// localeDateLayouts maps lower-case locale tags to the time.Parse layouts of their short date formats.
+// In the layouts using "1" and "2", day and month numbers may be given with or without a leading zero.
+// The ISO 8601 layouts ("2006-01-02") require zero-padded day and month numbers.
+var localeDateLayouts = map[string]string{
+	"en-us": "1/2/2006",
+	"en-gb": "2/1/2006",
+	"en-au": "2/1/2006",
+	"en-ca": "2006-01-02",
+	"en-in": "2/1/2006",
+	"de-de": "2.1.2006",
+	"de-at": "2.1.2006",
+	"de-ch": "2.1.2006",
+	"fr-fr": "2/1/2006",
+	"fr-ca": "2006-01-02",
+	"es-es": "2/1/2006",
+	"it-it": "2/1/2006",
+	"nl-nl": "2-1-2006",
+	"pt-br": "2/1/2006",
+	"pl-pl": "2.1.2006",
+	"ru-ru": "2.1.2006",
+	"sv-se": "2006-01-02",
+	"ja-jp": "2006/1/2",
+	"zh-cn": "2006/1/2",
+	"ko-kr": "2006. 1. 2.",
+}
+
+// LocalizedDate returns a validation rule that checks if a string is a date in the short date format of
+// the given locale, e.g. "31/12/2024" for "en-GB" and "12/31/2024" for "en-US". The locale is a BCP 47
+// language tag; both "-" and "_" separators are accepted and letter case is ignored.
+// By calling Min() and/or Max(), you can let the rule check if the parsed date is within the specified range.
+// If the locale is not supported, the rule returns an internal error.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func LocalizedDate(locale string) LocalizedDateRule {
+	return LocalizedDateRule{
+		locale:   locale,
+		err:      ErrLocalizedDateInvalid,
+		rangeErr: ErrLocalizedDateOutOfRange,
+	}
+}
+
+// LocalizedDateRule is a validation rule that validates date strings in a locale's short date format.
+type LocalizedDateRule struct {
+	locale        string
+	min, max      time.Time
+	err, rangeErr Error
+}
+
+// Min sets the minimum date range. A zero value means skipping the minimum range validation.
+func (r LocalizedDateRule) Min(min time.Time) LocalizedDateRule {
+	r.min = min
+	return r
+}
+
+// Max sets the maximum date range. A zero value means skipping the maximum range validation.
+func (r LocalizedDateRule) Max(max time.Time) LocalizedDateRule {
+	r.max = max
+	return r
+}
+
+// Validate checks if the given value is a valid date for the locale.
+func (r LocalizedDateRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	str, err := EnsureString(value)
+	if err != nil {
+		return err
+	}
+
+	layout, ok := localeDateLayouts[strings.ToLower(strings.Replace(r.locale, "_", "-", -1))]
+	if !ok {
+		return NewInternalError(fmt.Errorf("unsupported locale %q", r.locale))
+	}
+
+	date, err := time.Parse(layout, str)
+	if err != nil {
+		return r.err.SetParams(map[string]interface{}{"locale": r.locale})
+	}
+
+	if !r.min.IsZero() && r.min.After(date) || !r.max.IsZero() && date.After(r.max) {
+		return r.rangeErr
+	}
+
+	return nil
+}
+
+// Error sets the error message that is used when the value being validated is not a valid date.
+func (r LocalizedDateRule) Error(message string) LocalizedDateRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct that is used when the value being validated is not a valid date.
+func (r LocalizedDateRule) ErrorObject(err Error) LocalizedDateRule {
+	r.err = err
+	return r
+}
+
+// RangeError sets the error message that is used when the date is out of the specified Min/Max range.
+func (r LocalizedDateRule) RangeError(message string) LocalizedDateRule {
+	r.rangeErr = r.rangeErr.SetMessage(message)
+	return r
+}
+
+// RangeErrorObject sets the error struct that is used when the date is out of the specified Min/Max range.
+func (r LocalizedDateRule) RangeErrorObject(err Error) LocalizedDateRule {
+	r.rangeErr = err
+	return r
+}
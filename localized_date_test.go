@@ -0,0 +1,69 @@
+package validate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocalizedDate(t *testing.T) {
+	tests := []struct {
+		tag    string
+		locale string
+		value  interface{}
+		err    string
+	}{
+		{"t1", "en-GB", "", ""},
+		{"t2", "en-GB", "31/12/2024", ""},
+		{"t3", "en-GB", "12/31/2024", "must be a valid date for locale en-GB"},
+		{"t4", "en-US", "12/31/2024", ""},
+		{"t5", "en-US", "31/12/2024", "must be a valid date for locale en-US"},
+		{"t6", "en_us", "1/2/2024", ""},
+		{"t7", "de-DE", "31.12.2024", ""},
+		{"t8", "de-DE", "31/12/2024", "must be a valid date for locale de-DE"},
+		{"t9", "ja-JP", "2024/12/31", ""},
+		{"t10", "en-GB", "30/02/2024", "must be a valid date for locale en-GB"},
+		{"t11", "xx-XX", "31/12/2024", "unsupported locale \"xx-XX\""},
+		{"t12", "en-GB", 20241231, "must be either a string or byte slice"},
+		{"t13", "en-GB", "01/02/2024", ""},
+		{"t14", "sv-SE", "2024-01-02", ""},
+		{"t15", "sv-SE", "2024-1-2", "must be a valid date for locale sv-SE"},
+		{"t16", "en-CA", "2024-12-31", ""},
+	}
+
+	for _, test := range tests {
+		r := LocalizedDate(test.locale)
+		err := r.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestLocalizedDateRule_MinMax(t *testing.T) {
+	r := LocalizedDate("en-GB").
+		Min(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)).
+		Max(time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC))
+
+	assert.Nil(t, r.Validate("1/1/2024"))
+	assert.Nil(t, r.Validate("31/12/2024"))
+	assert.EqualError(t, r.Validate("31/12/2023"), "the date is out of range")
+	assert.EqualError(t, r.Validate("01/01/2025"), "the date is out of range")
+}
+
+func TestLocalizedDateRule_Error(t *testing.T) {
+	r := LocalizedDate("en-GB").Min(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	r = r.Error("bad date for {{.locale}}").RangeError("too early")
+	assert.Equal(t, "bad date for en-GB", r.Validate("abc").Error())
+	assert.Equal(t, "too early", r.Validate("31/12/2023").Error())
+}
+
+func TestLocalizedDateRule_ErrorObject(t *testing.T) {
+	r := LocalizedDate("en-GB")
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err).RangeErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err, r.rangeErr)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
@@ -0,0 +1,133 @@
+package validate
+
+import "reflect"
+
+var (
+	// ErrMapMaxValue is the error that returns when the largest value of a map exceeds a limit.
+	ErrMapMaxValue = NewError("validation_map_max_value", "values must be no greater than {{.max}}")
+	// ErrMapMinValue is the error that returns when the smallest value of a map is below a limit.
+	ErrMapMinValue = NewError("validation_map_min_value", "values must be no less than {{.min}}")
+)
+
+// MapAggregate returns a validation rule that reduces the values of a map into a single result and checks it.
+// The reduce function is called once per map value with the accumulated result so far, which is nil
+// for the first value, and returns the new accumulated result. The final result is passed to check,
+// whose error is returned as the validation error. Because map iteration order is random, reduce should
+// not depend on the order of the values. For example, to count the non-zero values of a map:
+//    validation.MapAggregate(func(acc, v interface{}) interface{} {
+//        n, _ := acc.(int)
+//        if !validation.IsEmpty(v) {
+//            n++
+//        }
+//        return n
+//    }, func(result interface{}) error {
+//        if result.(int) > 3 {
+//            return errors.New("at most 3 values may be set")
+//        }
+//        return nil
+//    })
+//
+// An empty map is considered valid. Use the Required rule to make sure a map is not empty.
+func MapAggregate(reduce func(acc, v interface{}) interface{}, check func(interface{}) error) MapAggregateRule {
+	return MapAggregateRule{
+		reduce: reduce,
+		check:  check,
+	}
+}
+
+// MapMaxValue returns a validation rule that checks if no value of a map is greater than max.
+// The map values must be of int, uint or float types.
+// An empty map is considered valid. Use the Required rule to make sure a map is not empty.
+func MapMaxValue(max float64) MapAggregateRule {
+	return MapAggregate(reduceNumbers(func(acc, n float64) bool { return n > acc }), func(result interface{}) error {
+		if err, ok := result.(error); ok {
+			return err
+		}
+		if result.(float64) > max {
+			return ErrMapMaxValue.SetParams(map[string]interface{}{"max": max})
+		}
+		return nil
+	})
+}
+
+// MapMinValue returns a validation rule that checks if no value of a map is less than min.
+// The map values must be of int, uint or float types.
+// An empty map is considered valid. Use the Required rule to make sure a map is not empty.
+func MapMinValue(min float64) MapAggregateRule {
+	return MapAggregate(reduceNumbers(func(acc, n float64) bool { return n < acc }), func(result interface{}) error {
+		if err, ok := result.(error); ok {
+			return err
+		}
+		if result.(float64) < min {
+			return ErrMapMinValue.SetParams(map[string]interface{}{"min": min})
+		}
+		return nil
+	})
+}
+
+// reduceNumbers returns a reduce function that keeps the number for which replace returns true.
+// If a value cannot be converted to a number, the conversion error becomes the result.
+func reduceNumbers(replace func(acc, n float64) bool) func(acc, v interface{}) interface{} {
+	return func(acc, v interface{}) interface{} {
+		if _, ok := acc.(error); ok {
+			return acc
+		}
+		n, err := ToNumber(v)
+		if err != nil {
+			return err
+		}
+		if acc == nil || replace(acc.(float64), n) {
+			return n
+		}
+		return acc
+	}
+}
+
+// MapAggregateRule is a validation rule that checks an aggregate of the values of a map.
+type MapAggregateRule struct {
+	reduce func(acc, v interface{}) interface{}
+	check  func(interface{}) error
+	err    Error
+}
+
+// Validate checks if the given value is valid or not.
+func (r MapAggregateRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Map {
+		return NewInternalError(ErrNotMap)
+	}
+
+	var acc interface{}
+	iter := v.MapRange()
+	for iter.Next() {
+		acc = r.reduce(acc, iter.Value().Interface())
+	}
+
+	err := r.check(acc)
+	if e, ok := err.(Error); ok && r.err != nil {
+		return r.err.SetParams(e.Params())
+	}
+	return err
+}
+
+// Error sets the error message that replaces validation errors returned by the check function.
+// The parameters of the original error are kept, so they can be used in the message.
+func (r MapAggregateRule) Error(message string) MapAggregateRule {
+	if r.err == nil {
+		r.err = NewError("validation_map_aggregate", message)
+	} else {
+		r.err = r.err.SetMessage(message)
+	}
+	return r
+}
+
+// ErrorObject sets the error struct that replaces validation errors returned by the check function.
+func (r MapAggregateRule) ErrorObject(err Error) MapAggregateRule {
+	r.err = err
+	return r
+}
@@ -0,0 +1,105 @@
+package validate
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapAggregate(t *testing.T) {
+	countSet := func(acc, v interface{}) interface{} {
+		n, _ := acc.(int)
+		if !IsEmpty(v) {
+			n++
+		}
+		return n
+	}
+	atMostTwo := func(result interface{}) error {
+		if result.(int) > 2 {
+			return errors.New("at most 2 values may be set")
+		}
+		return nil
+	}
+	var nilMap map[string]int
+
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", nilMap, ""},
+		{"t2", map[string]int{}, ""},
+		{"t3", map[string]int{"a": 1, "b": 0, "c": 2}, ""},
+		{"t4", map[string]int{"a": 1, "b": 3, "c": 2}, "at most 2 values may be set"},
+		{"t5", &map[string]int{"a": 1}, ""},
+		{"t6", []int{1, 2, 3}, "only a map can be validated"},
+	}
+
+	for _, test := range tests {
+		err := MapAggregate(countSet, atMostTwo).Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	err := MapAggregate(countSet, atMostTwo).Validate([]int{1})
+	_, ok := err.(InternalError)
+	assert.True(t, ok)
+}
+
+func TestMapMaxValue(t *testing.T) {
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", map[string]int{}, ""},
+		{"t2", map[string]int{"a": 1, "b": 10}, ""},
+		{"t3", map[string]int{"a": 1, "b": 11}, "values must be no greater than 10"},
+		{"t4", map[string]float64{"a": 10.5}, "values must be no greater than 10"},
+		{"t5", map[string]uint{"a": 3}, ""},
+		{"t6", map[string]string{"a": "x"}, "cannot convert string to a number"},
+	}
+
+	for _, test := range tests {
+		err := MapMaxValue(10).Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestMapMinValue(t *testing.T) {
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", map[string]int{}, ""},
+		{"t2", map[string]int{"a": 1, "b": 10}, ""},
+		{"t3", map[string]int{"a": 0, "b": 10}, "values must be no less than 1"},
+		{"t4", map[int]float64{1: 0.5, 2: 3}, "values must be no less than 1"},
+		{"t5", map[string]interface{}{"a": 2, "b": "x"}, "cannot convert string to a number"},
+	}
+
+	for _, test := range tests {
+		err := MapMinValue(1).Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestMapAggregateRule_Error(t *testing.T) {
+	r := MapMaxValue(10).Error("too much, limit is {{.max}}")
+	assert.Equal(t, "too much, limit is 10", r.Validate(map[string]int{"a": 11}).Error())
+	assert.Equal(t, "cannot convert string to a number", r.Validate(map[string]string{"a": "x"}).Error())
+
+	r = r.Error("123")
+	assert.Equal(t, "123", r.err.Message())
+}
+
+func TestMapAggregateRule_ErrorObject(t *testing.T) {
+	r := MapMaxValue(10)
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
@@ -54,3 +54,17 @@ func (r MatchRule) ErrorObject(err Error) MatchRule {
 	r.err = err
 	return r
 }
+
+// Hint sets a human-friendly description or example of the expected format, e.g. "YYYY-MM-DD".
+// The hint is stored in the "hint" param of the error, so a custom message or a translator can render it:
+//    validation.Match(re).Hint("YYYY-MM-DD").Error("must match the format: {{.hint}}")
+// The default message does not include the hint.
+func (r MatchRule) Hint(hint string) MatchRule {
+	params := map[string]interface{}{}
+	for k, v := range r.err.Params() {
+		params[k] = v
+	}
+	params["hint"] = hint
+	r.err = r.err.SetParams(params)
+	return r
+}
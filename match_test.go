@@ -53,3 +53,31 @@ func TestMatchRule_ErrorObject(t *testing.T) {
 	assert.Equal(t, err.Code(), r.err.Code())
 	assert.Equal(t, err.Message(), r.err.Message())
 }
+
+func TestMatchRule_Hint(t *testing.T) {
+	r := Match(regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)).Hint("YYYY-MM-DD")
+
+	err := r.Validate("2020/01/02")
+	if assert.NotNil(t, err) {
+		e := err.(Error)
+		assert.Equal(t, ErrMatchInvalid.Code(), e.Code())
+		assert.Equal(t, map[string]interface{}{"hint": "YYYY-MM-DD"}, e.Params())
+		assert.Equal(t, "must be in a valid format", e.Error())
+	}
+	assert.Nil(t, r.Validate("2020-01-02"))
+
+	// the default error is not modified
+	assert.Empty(t, ErrMatchInvalid.Params())
+
+	// the hint can be rendered by a custom message or a translator
+	r = r.Error("must match the format: {{.hint}}")
+	assert.EqualError(t, r.Validate("x"), "must match the format: YYYY-MM-DD")
+	tr := TranslatorFunc(func(code string, params map[string]interface{}) string {
+		return "muss dem Format {{.hint}} entsprechen"
+	})
+	assert.Equal(t, "muss dem Format YYYY-MM-DD entsprechen", r.Validate("x").(ErrorObject).Localize(tr).Error())
+
+	// the hint is kept along with the existing params of a custom error
+	r = Match(regexp.MustCompile("[a-z]+")).ErrorObject(NewError("code", "{{.field}}: use {{.hint}}").SetParams(map[string]interface{}{"field": "name"})).Hint("lowercase letters")
+	assert.EqualError(t, r.Validate("13"), "name: use lowercase letters")
+}
@@ -0,0 +1,89 @@
+package validate
+
+import "errors"
+
+var (
+	// ErrNotRectangular is the error that returns when the rows of a matrix have different lengths.
+	ErrNotRectangular = NewError("validation_not_rectangular", "all rows must have the same number of columns")
+	// ErrNotSquare is the error that returns when a matrix does not have as many rows as columns.
+	ErrNotSquare = NewError("validation_not_square", "must have the same number of rows and columns")
+)
+
+// Rectangular returns a validation rule that checks if all rows of a matrix, given as a slice or array of
+// slices or arrays such as [][]float64, have the same length. The (zero-based) index of the first row whose
+// length differs from the first row is reported in the "row" parameter of the error.
+// Call Square to also require as many rows as columns.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func Rectangular() MatrixRule {
+	return MatrixRule{
+		err:       ErrNotRectangular,
+		squareErr: ErrNotSquare,
+	}
+}
+
+// MatrixRule is a validation rule that checks the shape of a matrix.
+type MatrixRule struct {
+	square         bool
+	err, squareErr Error
+}
+
+// Square makes the rule require an N×N matrix.
+func (r MatrixRule) Square() MatrixRule {
+	r.square = true
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r MatrixRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	v, err := sliceValue(value)
+	if err != nil {
+		return err
+	}
+
+	cols := -1
+	for i := 0; i < v.Len(); i++ {
+		row, err := sliceValue(v.Index(i).Interface())
+		if err != nil {
+			return errors.New("rows must be slices or arrays")
+		}
+		if cols < 0 {
+			cols = row.Len()
+		} else if row.Len() != cols {
+			return r.err.SetParams(map[string]interface{}{"row": i})
+		}
+	}
+
+	if r.square && cols != v.Len() {
+		return r.squareErr
+	}
+	return nil
+}
+
+// Error sets the error message that is used when the rows have different lengths.
+func (r MatrixRule) Error(message string) MatrixRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct that is used when the rows have different lengths.
+func (r MatrixRule) ErrorObject(err Error) MatrixRule {
+	r.err = err
+	return r
+}
+
+// SquareError sets the error message that is used when the matrix is not square.
+func (r MatrixRule) SquareError(message string) MatrixRule {
+	r.squareErr = r.squareErr.SetMessage(message)
+	return r
+}
+
+// SquareErrorObject sets the error struct that is used when the matrix is not square.
+func (r MatrixRule) SquareErrorObject(err Error) MatrixRule {
+	r.squareErr = err
+	return r
+}
@@ -0,0 +1,58 @@
+package validate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRectangular(t *testing.T) {
+	var nilMatrix [][]float64
+
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", nilMatrix, ""},
+		{"t2", [][]float64{}, ""},
+		{"t3", [][]float64{{1, 2, 3}, {4, 5, 6}}, ""},
+		{"t4", [][]float64{{1, 2}, {3, 4}, {5}}, "all rows must have the same number of columns"},
+		{"t5", [][]float64{{}, {}}, ""},
+		{"t6", [][]float64{nil, {1}}, "all rows must have the same number of columns"},
+		{"t7", [2][2]int{{1, 2}, {3, 4}}, ""},
+		{"t8", &[][]string{{"a"}, {"b", "c"}}, "all rows must have the same number of columns"},
+		{"t9", []float64{1, 2}, "rows must be slices or arrays"},
+		{"t10", 1.5, "must be a slice or an array"},
+	}
+
+	for _, test := range tests {
+		err := Rectangular().Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestMatrixRule_Square(t *testing.T) {
+	r := Rectangular().Square()
+	assert.Nil(t, r.Validate([][]float64{{1, 0}, {0, 1}}))
+	assert.Nil(t, r.Validate([][]float64{{1}}))
+	assert.EqualError(t, r.Validate([][]float64{{1, 2, 3}, {4, 5, 6}}), "must have the same number of rows and columns")
+	assert.EqualError(t, r.Validate([][]float64{{1, 2}, {3}}), "all rows must have the same number of columns")
+}
+
+func TestMatrixRule_Error(t *testing.T) {
+	r := Rectangular().Square().Error("row {{.row}} is ragged").SquareError("not square")
+	assert.Equal(t, "row 1 is ragged", r.Validate([][]int{{1}, {1, 2}}).Error())
+	assert.Equal(t, "not square", r.Validate([][]int{{1, 2}}).Error())
+}
+
+func TestMatrixRule_ErrorObject(t *testing.T) {
+	r := Rectangular()
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err).SquareErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err, r.squareErr)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
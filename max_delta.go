@@ -0,0 +1,69 @@
+package validate
+
+import "math"
+
+// ErrMaxDelta is the error that returns when consecutive elements differ too much.
+var ErrMaxDelta = NewError("validation_max_delta", "value changed too abruptly at item {{.index}}")
+
+// MaxDelta returns a validation rule that checks if consecutive elements of a slice or array differ by at most limit,
+// e.g. to reject physically implausible jumps in sensor readings. The extractor returns the number of an element;
+// if it is nil, the elements must be of int, uint or float types. The (zero-based) index of the first element
+// that differs too much from its predecessor is reported in the "index" parameter of the error.
+// For example,
+//    validation.MaxDelta(5, func(v interface{}) float64 { return v.(Reading).Celsius })
+//
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func MaxDelta(limit float64, extractor func(interface{}) float64) MaxDeltaRule {
+	return MaxDeltaRule{
+		limit:     limit,
+		extractor: extractor,
+		err:       ErrMaxDelta,
+	}
+}
+
+// MaxDeltaRule is a validation rule that checks the change between consecutive elements of a slice.
+type MaxDeltaRule struct {
+	limit     float64
+	extractor func(interface{}) float64
+	err       Error
+}
+
+// Validate checks if the given value is valid or not.
+func (r MaxDeltaRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	v, err := sliceValue(value)
+	if err != nil {
+		return err
+	}
+
+	var prev float64
+	for i := 0; i < v.Len(); i++ {
+		var n float64
+		if r.extractor != nil {
+			n = r.extractor(v.Index(i).Interface())
+		} else if n, err = ToNumber(v.Index(i).Interface()); err != nil {
+			return err
+		}
+		if i > 0 && !(math.Abs(n-prev) <= r.limit) {
+			return r.err.SetParams(map[string]interface{}{"index": i})
+		}
+		prev = n
+	}
+	return nil
+}
+
+// Error sets the error message for the rule.
+func (r MaxDeltaRule) Error(message string) MaxDeltaRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r MaxDeltaRule) ErrorObject(err Error) MaxDeltaRule {
+	r.err = err
+	return r
+}
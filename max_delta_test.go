@@ -0,0 +1,61 @@
+package validate
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMaxDelta(t *testing.T) {
+	var nilSlice []float64
+
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", nilSlice, ""},
+		{"t2", []float64{}, ""},
+		{"t3", []float64{20}, ""},
+		{"t4", []float64{20, 22.5, 25, 22, 17}, ""},
+		{"t5", []float64{20, 21, 22, 23, 24, 30}, "value changed too abruptly at item 5"},
+		{"t6", []int{10, 5, 0, -6}, "value changed too abruptly at item 3"},
+		{"t7", [3]uint{1, 2, 3}, ""},
+		{"t8", []float64{1, math.NaN()}, "value changed too abruptly at item 1"},
+		{"t9", []string{"a", "b"}, "cannot convert string to a number"},
+		{"t10", 5, "must be a slice or an array"},
+	}
+
+	for _, test := range tests {
+		err := MaxDelta(5, nil).Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestMaxDelta_Extractor(t *testing.T) {
+	type reading struct {
+		Sensor  string
+		Celsius float64
+	}
+	celsius := func(v interface{}) float64 { return v.(reading).Celsius }
+	readings := []reading{{"a", 20}, {"a", 20.5}, {"a", 85}, {"a", 21}}
+
+	assert.EqualError(t, MaxDelta(2, celsius).Validate(readings), "value changed too abruptly at item 2")
+	assert.Nil(t, MaxDelta(100, celsius).Validate(readings))
+}
+
+func TestMaxDeltaRule_Error(t *testing.T) {
+	r := MaxDelta(1, nil).Error("reading {{.index}} is implausible")
+	assert.Equal(t, "reading 1 is implausible", r.Validate([]int{1, 3}).Error())
+}
+
+func TestMaxDeltaRule_ErrorObject(t *testing.T) {
+	r := MaxDelta(1, nil)
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
@@ -38,7 +38,7 @@ const (
 // Min returns a validation rule that checks if a value is greater or equal than the specified value.
 // By calling Exclusive, the rule will check if the value is strictly greater than the specified value.
 // Note that the value being checked and the threshold value must be of the same type.
-// Only int, uint, float and time.Time types are supported.
+// Only int, uint, float, time.Duration and time.Time types are supported.
 // An empty value is considered valid. Please use the Required rule to make sure a value is not empty.
 func Min(min interface{}) ThresholdRule {
 	return ThresholdRule{
@@ -52,7 +52,7 @@ func Min(min interface{}) ThresholdRule {
 // Max returns a validation rule that checks if a value is less or equal than the specified value.
 // By calling Exclusive, the rule will check if the value is strictly less than the specified value.
 // Note that the value being checked and the threshold value must be of the same type.
-// Only int, uint, float and time.Time types are supported.
+// Only int, uint, float, time.Duration and time.Time types are supported.
 // An empty value is considered valid. Please use the Required rule to make sure a value is not empty.
 func Max(max interface{}) ThresholdRule {
 	return ThresholdRule{
@@ -127,7 +127,16 @@ func (r ThresholdRule) Validate(value interface{}) error {
 		return fmt.Errorf("type not supported: %v", rv.Type())
 	}
 
-	return r.err.SetParams(map[string]interface{}{"threshold": r.threshold})
+	return r.err.SetParams(map[string]interface{}{"threshold": formatThreshold(r.threshold)})
+}
+
+// formatThreshold formats a threshold for an error message.
+func formatThreshold(threshold interface{}) interface{} {
+	if d, ok := threshold.(time.Duration); ok {
+		// format durations as "5s" instead of their number of nanoseconds
+		return d.String()
+	}
+	return threshold
 }
 
 // Error sets the error message for the rule.
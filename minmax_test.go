@@ -137,6 +137,39 @@ func TestMaxError(t *testing.T) {
 	assert.Equal(t, "123", r.err.Message())
 }
 
+func TestThresholdRule_Duration(t *testing.T) {
+	tests := []struct {
+		tag   string
+		rule  ThresholdRule
+		value interface{}
+		err   string
+	}{
+		{"t1", Min(5 * time.Second), 5 * time.Second, ""},
+		{"t2", Min(5 * time.Second), 4 * time.Second, "must be no less than 5s"},
+		{"t3", Min(5 * time.Second).Exclusive(), 5 * time.Second, "must be greater than 5s"},
+		{"t4", Min(5 * time.Second).Exclusive(), 5001 * time.Millisecond, ""},
+		{"t5", Max(90 * time.Minute), 90 * time.Minute, ""},
+		{"t6", Max(90 * time.Minute), 91 * time.Minute, "must be no greater than 1h30m0s"},
+		{"t7", Max(90 * time.Minute).Exclusive(), 90 * time.Minute, "must be less than 1h30m0s"},
+		{"t8", Max(90 * time.Minute).Exclusive(), time.Duration(0), ""},
+		{"t9", Min(time.Second), "1s", "cannot convert string to int64"},
+	}
+
+	for _, test := range tests {
+		err := test.rule.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	err := Min(5 * time.Second).Validate(time.Second)
+	if e, ok := err.(ErrorObject); assert.True(t, ok) {
+		assert.Equal(t, "5s", e.Params()["threshold"])
+	}
+
+	s := struct{ Timeout time.Duration }{time.Second}
+	err = ValidateStruct(&s, Field(&s.Timeout, Min(2*time.Second), Max(time.Minute)))
+	assert.EqualError(t, err, "Timeout: must be no less than 2s.")
+}
+
 func TestThresholdRule_ErrorObject(t *testing.T) {
 	r := Max(10)
 	err := NewError("code", "abc")
@@ -1,15 +1,22 @@
 package validate
 
 import (
+	"errors"
 	"fmt"
 	"reflect"
 )
 
 // ErrMultipleOfInvalid is the error that returns when a value is not multiple of a base.
-var ErrMultipleOfInvalid = NewError("validation_multiple_of_invalid", "must be multiple of {{.base}}")
+var ErrMultipleOfInvalid = NewError("validation_multiple_of_invalid", "must be a multiple of {{.base}}")
+
+// errMultipleOfZero is the error that the base of a MultipleOf rule is zero.
+var errMultipleOfZero = errors.New("the base of MultipleOf must not be zero")
 
 // MultipleOf returns a validation rule that checks if a value is a multiple of the "base" value.
-// Note that "base" should be of integer type.
+// Note that "base" should be of integer type. Floating-point numbers are not supported, because their
+// rounding makes divisibility unreliable: a base of any other type or a zero base results in an internal
+// error, and a value that cannot be converted to the type of the base results in an error.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
 func MultipleOf(base interface{}) MultipleOfRule {
 	return MultipleOfRule{
 		base: base,
@@ -38,6 +45,24 @@ func (r MultipleOfRule) ErrorObject(err Error) MultipleOfRule {
 // Validate checks if the value is a multiple of the "base" value.
 func (r MultipleOfRule) Validate(value interface{}) error {
 	rv := reflect.ValueOf(r.base)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if rv.Int() == 0 {
+			return NewInternalError(errMultipleOfZero)
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if rv.Uint() == 0 {
+			return NewInternalError(errMultipleOfZero)
+		}
+	default:
+		return NewInternalError(fmt.Errorf("type not supported: %v", reflect.TypeOf(r.base)))
+	}
+
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
 	switch rv.Kind() {
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
 		v, err := ToInt(value)
@@ -48,7 +73,7 @@ func (r MultipleOfRule) Validate(value interface{}) error {
 			return nil
 		}
 
-	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+	default:
 		v, err := ToUint(value)
 		if err != nil {
 			return err
@@ -57,8 +82,6 @@ func (r MultipleOfRule) Validate(value interface{}) error {
 		if v%rv.Uint() == 0 {
 			return nil
 		}
-	default:
-		return fmt.Errorf("type not supported: %v", rv.Type())
 	}
 
 	return r.err.SetParams(map[string]interface{}{"base": r.base})
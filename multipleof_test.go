@@ -5,6 +5,7 @@
 package validate
 
 import (
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -12,23 +13,66 @@ import (
 
 func TestMultipleOf(t *testing.T) {
 	r := MultipleOf(10)
-	assert.Equal(t, "must be multiple of 10", r.Validate(11).Error())
+	assert.Equal(t, "must be a multiple of 10", r.Validate(11).Error())
 	assert.Equal(t, nil, r.Validate(20))
 	assert.Equal(t, "cannot convert float32 to int64", r.Validate(float32(20)).Error())
 
 	r2 := MultipleOf("some string ....")
-	assert.Equal(t, "type not supported: string", r2.Validate(10).Error())
+	err := r2.Validate(10)
+	assert.Equal(t, "type not supported: string", err.Error())
+	_, ok := err.(InternalError)
+	assert.True(t, ok)
 
 	r3 := MultipleOf(uint(10))
-	assert.Equal(t, "must be multiple of 10", r3.Validate(uint(11)).Error())
+	assert.Equal(t, "must be a multiple of 10", r3.Validate(uint(11)).Error())
 	assert.Equal(t, nil, r3.Validate(uint(20)))
 	assert.Equal(t, "cannot convert float32 to uint64", r3.Validate(float32(20)).Error())
+}
+
+func TestMultipleOf_Operands(t *testing.T) {
+	fifteen, sixteen := 15, 16
+	var nilInt *int
+	tests := []struct {
+		tag   string
+		base  interface{}
+		value interface{}
+		err   string
+	}{
+		{"t1", 5, 15, ""},
+		{"t2", 5, 16, "must be a multiple of 5"},
+		{"t3", 5, -15, ""},
+		{"t4", 5, -16, "must be a multiple of 5"},
+		{"t5", -5, 15, ""},
+		{"t6", -5, -15, ""},
+		{"t7", -5, 7, "must be a multiple of -5"},
+		{"t8", 5, 0, ""},
+		{"t9", int8(3), int64(9), ""},
+		{"t10", int64(-1), int64(math.MinInt64), ""},
+		{"t11", uint8(4), uint32(12), ""},
+		{"t12", uint8(4), uint32(10), "must be a multiple of 4"},
+		{"t13", 0, 10, "the base of MultipleOf must not be zero"},
+		{"t14", uint(0), uint(10), "the base of MultipleOf must not be zero"},
+		{"t15", 0.5, 1.0, "type not supported: float64"},
+		{"t16", 5, &fifteen, ""},
+		{"t17", 5, &sixteen, "must be a multiple of 5"},
+		{"t18", 5, nilInt, ""},
+		{"t19", 0, nilInt, "the base of MultipleOf must not be zero"},
+	}
+
+	for _, test := range tests {
+		err := MultipleOf(test.base).Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
 
+	for _, base := range []interface{}{0, uint(0), 0.5} {
+		_, ok := MultipleOf(base).Validate(10).(InternalError)
+		assert.True(t, ok, "%v", base)
+	}
 }
 
 func Test_MultipleOf_Error(t *testing.T) {
 	r := MultipleOf(10)
-	assert.Equal(t, "must be multiple of 10", r.Validate(3).Error())
+	assert.Equal(t, "must be a multiple of 10", r.Validate(3).Error())
 
 	r = r.Error("some error string ...")
 	assert.Equal(t, "some error string ...", r.err.Message())
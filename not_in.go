@@ -4,11 +4,16 @@
 
 package validate
 
+import (
+	"reflect"
+)
+
 // ErrNotInInvalid is the error that returns when a value is in a list.
 var ErrNotInInvalid = NewError("validation_not_in_invalid", "must not be in list")
 
 // NotIn returns a validation rule that checks if a value is absent from the given list of values.
-// Note that the value being checked and the possible range of values must be of the same type.
+// Like for In, reflect.DeepEqual() is used to determine if two values are equal, so a value only
+// matches the listed values of the same type, e.g. int64(1) does not match 1.
 // An empty value is considered valid. Use the Required rule to make sure a value is not empty.
 func NotIn(values ...interface{}) NotInRule {
 	return NotInRule{
@@ -31,7 +36,7 @@ func (r NotInRule) Validate(value interface{}) error {
 	}
 
 	for _, e := range r.elements {
-		if e == value {
+		if reflect.DeepEqual(e, value) {
 			return r.err
 		}
 	}
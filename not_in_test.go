@@ -27,6 +27,13 @@ func TestNotIn(t *testing.T) {
 		{"t5", []interface{}{1, 2}, "1", ""},
 		{"t6", []interface{}{1, 2}, &v, "must not be in list"},
 		{"t7", []interface{}{1, 2}, v2, ""},
+		{"t8", []interface{}{[]byte{1}, 1, 2}, []byte{1}, "must not be in list"},
+		{"t9", []interface{}{[]byte{1}, 1, 2}, []byte{2}, ""},
+		{"t10", []interface{}{1, 2}, int64(1), ""},
+		{"t11", []interface{}{int64(1), 2}, int64(1), "must not be in list"},
+		{"t12", []interface{}{1.0, 2}, 1, ""},
+		{"t13", []interface{}{"admin", "root"}, "root", "must not be in list"},
+		{"t14", []interface{}{"admin", "root"}, "", ""},
 	}
 
 	for _, test := range tests {
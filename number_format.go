@@ -0,0 +1,126 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// ErrNumberFormat is the error that returns when a string does not match a number format pattern.
+var ErrNumberFormat = NewError("validation_number_format", "must match the format {{.pattern}}")
+
+// NumberFormat returns a validation rule that checks if a string is a number formatted according to
+// an ICU-style decimal pattern, such as "#,##0.00" or "$#,##0.00".
+//
+// The pattern supports the following subset of the ICU syntax:
+// - "0" stands for a required digit and "#" for an optional one;
+// - "," marks a grouping separator; the number of digit placeholders after the last one gives the group size;
+// - "." marks the decimal separator; the "0"s after it give the minimum and the "#"s the additional
+//   optional fraction digits;
+// - any other characters before or after the number part are treated as a literal prefix or suffix.
+// A leading minus sign is accepted after the prefix.
+//
+// If the pattern cannot be parsed, the rule returns an internal error.
+// This rule should only be used for validating strings and byte slices.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func NumberFormat(pattern string) NumberFormatRule {
+	re, err := compileNumberFormat(pattern)
+	return NumberFormatRule{
+		pattern:    pattern,
+		re:         re,
+		patternErr: err,
+		err:        ErrNumberFormat,
+	}
+}
+
+// NumberFormatRule is a validation rule that checks if a string matches a number format pattern.
+type NumberFormatRule struct {
+	pattern    string
+	re         *regexp.Regexp
+	patternErr error
+	err        Error
+}
+
+// Validate checks if the given value is valid or not.
+func (r NumberFormatRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	str, err := EnsureString(value)
+	if err != nil {
+		return err
+	}
+
+	if r.patternErr != nil {
+		return NewInternalError(r.patternErr)
+	}
+
+	if r.re.MatchString(str) {
+		return nil
+	}
+	return r.err.SetParams(map[string]interface{}{"pattern": r.pattern})
+}
+
+// Error sets the error message for the rule.
+func (r NumberFormatRule) Error(message string) NumberFormatRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r NumberFormatRule) ErrorObject(err Error) NumberFormatRule {
+	r.err = err
+	return r
+}
+
+// compileNumberFormat converts an ICU-style decimal pattern into a regular expression.
+func compileNumberFormat(pattern string) (*regexp.Regexp, error) {
+	start := strings.IndexAny(pattern, "#0")
+	if start < 0 {
+		return nil, fmt.Errorf("number format %q has no digit placeholders", pattern)
+	}
+	end := start
+	for end < len(pattern) && strings.IndexByte("#0,.", pattern[end]) >= 0 {
+		end++
+	}
+	prefix, number, suffix := pattern[:start], pattern[start:end], pattern[end:]
+
+	integer, fraction := number, ""
+	if i := strings.IndexByte(number, '.'); i >= 0 {
+		integer, fraction = number[:i], number[i+1:]
+	}
+	if strings.ContainsAny(fraction, ",.") {
+		return nil, fmt.Errorf("number format %q has an invalid fraction part", pattern)
+	}
+
+	var b strings.Builder
+	b.WriteString("^")
+	b.WriteString(regexp.QuoteMeta(prefix))
+	b.WriteString("-?")
+
+	if i := strings.LastIndexByte(integer, ','); i >= 0 {
+		size := len(integer) - i - 1
+		if size == 0 {
+			return nil, fmt.Errorf("number format %q has an empty digit group", pattern)
+		}
+		fmt.Fprintf(&b, `\d{1,%d}(?:,\d{%d})*`, size, size)
+	} else if min := strings.Count(integer, "0"); min > 0 {
+		fmt.Fprintf(&b, `\d{%d,}`, min)
+	} else {
+		b.WriteString(`\d*`)
+	}
+
+	minFraction := strings.Count(fraction, "0")
+	if maxFraction := len(fraction); minFraction > 0 {
+		fmt.Fprintf(&b, `\.\d{%d,%d}`, minFraction, maxFraction)
+	} else if maxFraction > 0 {
+		fmt.Fprintf(&b, `(?:\.\d{1,%d})?`, maxFraction)
+	}
+
+	b.WriteString(regexp.QuoteMeta(suffix))
+	b.WriteString("$")
+
+	return regexp.Compile(b.String())
+}
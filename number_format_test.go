@@ -0,0 +1,62 @@
+package validate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNumberFormat(t *testing.T) {
+	tests := []struct {
+		tag     string
+		pattern string
+		value   interface{}
+		err     string
+	}{
+		{"t1", "#,##0.00", "", ""},
+		{"t2", "#,##0.00", "0.00", ""},
+		{"t3", "#,##0.00", "1,234.56", ""},
+		{"t4", "#,##0.00", "1,234,567.89", ""},
+		{"t5", "#,##0.00", "-12.50", ""},
+		{"t6", "#,##0.00", "1234.56", "must match the format #,##0.00"},
+		{"t7", "#,##0.00", "1,234.5", "must match the format #,##0.00"},
+		{"t8", "#,##0.00", "1,23.56", "must match the format #,##0.00"},
+		{"t9", "$#,##0.00", "$1,234.56", ""},
+		{"t10", "$#,##0.00", "1,234.56", "must match the format $#,##0.00"},
+		{"t11", "#,##0.00 €", "1,234.56 €", ""},
+		{"t12", "0.0#", "3.1", ""},
+		{"t13", "0.0#", "3.14", ""},
+		{"t14", "0.0#", "3.141", "must match the format 0.0#"},
+		{"t15", "0.##", "3", ""},
+		{"t16", "00", "7", "must match the format 00"},
+		{"t17", "00", "07", ""},
+		{"t18", "#,##0.00", 1234.56, "must be either a string or byte slice"},
+		{"t19", "abc", "1", "number format \"abc\" has no digit placeholders"},
+		{"t20", "#,##0.0,0", "1", "number format \"#,##0.0,0\" has an invalid fraction part"},
+		{"t21", "#,", "1", "number format \"#,\" has an empty digit group"},
+	}
+
+	for _, test := range tests {
+		r := NumberFormat(test.pattern)
+		err := r.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestNumberFormatRule_Error(t *testing.T) {
+	r := NumberFormat("0.00")
+	assert.Equal(t, "must match the format 0.00", r.Validate("1").Error())
+
+	r = r.Error("use {{.pattern}}")
+	assert.Equal(t, "use 0.00", r.Validate("1").Error())
+}
+
+func TestNumberFormatRule_ErrorObject(t *testing.T) {
+	r := NumberFormat("0.00")
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
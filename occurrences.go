@@ -0,0 +1,125 @@
+package validate
+
+import (
+	"fmt"
+	"reflect"
+)
+
+var (
+	// ErrMaxOccurrences is the error that returns when a value appears too many times.
+	ErrMaxOccurrences = NewError("validation_max_occurrences", "'{{.value}}' appears more than {{.count}} times")
+	// ErrMinOccurrences is the error that returns when a value appears too few times.
+	ErrMinOccurrences = NewError("validation_min_occurrences", "'{{.value}}' appears fewer than {{.count}} times")
+)
+
+// MaxOccurrences returns a validation rule that checks if no value appears more than k times in a slice or array.
+// Elements are compared using the == operator, so they must be of comparable types; otherwise an
+// internal error is returned. The first offending value (in slice order) is reported in the "value"
+// parameter of the error.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func MaxOccurrences(k int) OccurrencesRule {
+	return OccurrencesRule{
+		count: k,
+		err:   ErrMaxOccurrences,
+	}
+}
+
+// MinOccurrences returns a validation rule that checks if every value appearing in a slice or array
+// appears at least k times.
+// Elements are compared using the == operator, so they must be of comparable types; otherwise an
+// internal error is returned. The first offending value (in slice order) is reported in the "value"
+// parameter of the error.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func MinOccurrences(k int) OccurrencesRule {
+	return OccurrencesRule{
+		count: k,
+		min:   true,
+		err:   ErrMinOccurrences,
+	}
+}
+
+// OccurrencesRule is a validation rule that checks how many times each distinct value appears in a slice.
+type OccurrencesRule struct {
+	count int
+	min   bool
+	err   Error
+}
+
+// Validate checks if the given value is valid or not.
+func (r OccurrencesRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	v, err := sliceValue(value)
+	if err != nil {
+		return err
+	}
+
+	counts := make(map[interface{}]int, v.Len())
+	for i := 0; i < v.Len(); i++ {
+		e, err := comparableElem(v.Index(i))
+		if err != nil {
+			return err
+		}
+		counts[e]++
+	}
+
+	for i := 0; i < v.Len(); i++ {
+		e := v.Index(i).Interface()
+		if n := counts[e]; r.min && n < r.count || !r.min && n > r.count {
+			return r.err.SetParams(map[string]interface{}{"value": e, "count": r.count})
+		}
+	}
+	return nil
+}
+
+// Error sets the error message for the rule.
+func (r OccurrencesRule) Error(message string) OccurrencesRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r OccurrencesRule) ErrorObject(err Error) OccurrencesRule {
+	r.err = err
+	return r
+}
+
+// comparableElem returns the element as an interface that can be used as a map key.
+// An internal error is returned if the element is not comparable, including when a struct field,
+// array element or interface value nested in it holds a value of a type that is not comparable.
+func comparableElem(e reflect.Value) (interface{}, error) {
+	if e.Kind() == reflect.Interface && !e.IsNil() {
+		e = e.Elem()
+	}
+	if !isComparable(e) {
+		return nil, NewInternalError(fmt.Errorf("cannot compare values of type %v", e.Type()))
+	}
+	return e.Interface(), nil
+}
+
+// isComparable checks if the given value can be compared without panicking. Unlike Type.Comparable,
+// it checks the dynamic types of the interface values nested in structs and arrays.
+func isComparable(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Interface:
+		return v.IsNil() || isComparable(v.Elem())
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if !isComparable(v.Field(i)) {
+				return false
+			}
+		}
+		return true
+	case reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if !isComparable(v.Index(i)) {
+				return false
+			}
+		}
+		return v.Type().Comparable()
+	}
+	return v.Type().Comparable()
+}
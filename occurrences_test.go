@@ -0,0 +1,76 @@
+package validate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMaxOccurrences(t *testing.T) {
+	type point struct{ X, Y int }
+
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", []string{}, ""},
+		{"t2", []string(nil), ""},
+		{"t3", []string{"red", "blue", "red"}, ""},
+		{"t4", []string{"blue", "red", "red", "red"}, "'red' appears more than 2 times"},
+		{"t5", [4]int{1, 2, 2, 2}, "'2' appears more than 2 times"},
+		{"t6", []interface{}{1, "1", 1, 1.0}, ""},
+		{"t7", []point{{1, 2}, {1, 2}, {1, 2}}, "'{1 2}' appears more than 2 times"},
+		{"t8", [][]int{{1}}, "cannot compare values of type []int"},
+		{"t9", []interface{}{1, []int{1}}, "cannot compare values of type []int"},
+		{"t10", "red", "must be a slice or an array"},
+	}
+
+	for _, test := range tests {
+		err := MaxOccurrences(2).Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	_, ok := MaxOccurrences(2).Validate([][]int{{1}}).(InternalError)
+	assert.True(t, ok)
+
+	type box struct{ V interface{} }
+	_, ok = MaxOccurrences(2).Validate([]box{{[]int{1}}, {[]int{1}}}).(InternalError)
+	assert.True(t, ok)
+}
+
+func TestMinOccurrences(t *testing.T) {
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", []string{}, ""},
+		{"t2", []string{"red", "red"}, ""},
+		{"t3", []string{"red", "blue", "red", "blue"}, ""},
+		{"t4", []string{"red", "blue", "red"}, "'blue' appears fewer than 2 times"},
+	}
+
+	for _, test := range tests {
+		err := MinOccurrences(2).Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestOccurrencesRule_Error(t *testing.T) {
+	r := MaxOccurrences(1)
+	assert.Equal(t, "'a' appears more than 1 times", r.Validate([]string{"a", "a"}).Error())
+
+	r = r.Error("{{.value}} is repeated")
+	assert.Equal(t, "a is repeated", r.Validate([]string{"a", "a"}).Error())
+}
+
+func TestOccurrencesRule_ErrorObject(t *testing.T) {
+	r := MaxOccurrences(1)
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
@@ -0,0 +1,51 @@
+package validate
+
+import (
+	"context"
+	"reflect"
+)
+
+// OptionalPtr returns a validation rule that skips validation when the value is a nil pointer and otherwise
+// validates the value the pointer points to with the given rules. This is useful for optional pointer fields
+// that must be valid when they are provided, e.g. in PATCH requests:
+//    validation.ValidateStruct(&req,
+//        validation.Field(&req.Name, validation.OptionalPtr(validation.Required, validation.Length(1, 50))),
+//    )
+//
+// A non-nil pointer to a zero value is dereferenced and the zero value is passed to the rules, which treat it
+// as they treat any empty value: Required fails with "cannot be blank", while rules such as Length skip it.
+// Only a nil pointer skips all rules, including Required. Values that are not pointers are validated as they are.
+func OptionalPtr(rules ...Rule) OptionalPtrRule {
+	return OptionalPtrRule{
+		rules: rules,
+	}
+}
+
+// OptionalPtrRule is a validation rule that validates the value a pointer points to if the pointer is not nil.
+type OptionalPtrRule struct {
+	rules []Rule
+}
+
+// Validate checks if the given value is valid or not.
+func (r OptionalPtrRule) Validate(value interface{}) error {
+	return r.ValidateWithContext(nil, value)
+}
+
+// ValidateWithContext checks if the given value is valid or not using the given context.
+func (r OptionalPtrRule) ValidateWithContext(ctx context.Context, value interface{}) error {
+	rv := reflect.ValueOf(value)
+	for rv.Kind() == reflect.Ptr || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return nil
+		}
+		rv = rv.Elem()
+	}
+	if !rv.IsValid() {
+		return nil
+	}
+
+	if ctx == nil {
+		return Validate(rv.Interface(), r.rules...)
+	}
+	return ValidateWithContext(ctx, rv.Interface(), r.rules...)
+}
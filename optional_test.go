@@ -0,0 +1,76 @@
+package validate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOptionalPtr(t *testing.T) {
+	var nilStr *string
+	var nilPtrPtr **string
+	empty, short, long := "", "ab", "abcdef"
+	shortPtr := &short
+
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", nil, ""},
+		{"t2", nilStr, ""},
+		{"t3", nilPtrPtr, ""},
+		{"t4", &empty, "cannot be blank"},
+		{"t5", &short, ""},
+		{"t6", &long, "the length must be between 1 and 5"},
+		{"t7", &shortPtr, ""},
+		{"t8", "abcdef", "the length must be between 1 and 5"},
+	}
+
+	for _, test := range tests {
+		r := OptionalPtr(Required, Length(1, 5))
+		err := r.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestOptionalPtr_ValidateStruct(t *testing.T) {
+	type patch struct {
+		Name  *string
+		Count *int
+	}
+	empty, zero, big := "", 0, 11
+
+	p := patch{}
+	assert.Nil(t, ValidateStruct(&p,
+		Field(&p.Name, OptionalPtr(Required)),
+		Field(&p.Count, OptionalPtr(Max(10))),
+	))
+
+	p = patch{Name: &empty, Count: &zero}
+	assert.EqualError(t, ValidateStruct(&p,
+		Field(&p.Name, OptionalPtr(Required)),
+		Field(&p.Count, OptionalPtr(Max(10))),
+	), "Name: cannot be blank.")
+
+	p = patch{Count: &big}
+	assert.EqualError(t, ValidateStruct(&p,
+		Field(&p.Count, OptionalPtr(Max(10))),
+	), "Count: must be no greater than 10.")
+}
+
+func TestOptionalPtrRule_ValidateWithContext(t *testing.T) {
+	s := "key"
+	r := OptionalPtr(WithContext(func(ctx context.Context, value interface{}) error {
+		if ctx.Value(inContextKey{}) == value {
+			return nil
+		}
+		return NewError("", "unexpected value")
+	}))
+	ctx := context.WithValue(context.Background(), inContextKey{}, "key")
+
+	assert.Nil(t, r.ValidateWithContext(ctx, &s))
+	assert.Nil(t, r.ValidateWithContext(ctx, (*string)(nil)))
+	assert.EqualError(t, r.ValidateWithContext(ctx, "other"), "unexpected value")
+}
@@ -0,0 +1,174 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ErrPattern is the error that returns when a string does not match a templated format.
+var ErrPattern = NewError("validation_pattern", "must match {{.format}}")
+
+// patternClasses maps the placeholder types of Pattern to regular expression classes and display characters.
+var patternClasses = map[byte]struct {
+	class   string
+	display string
+}{
+	'd': {`[0-9]`, "N"},
+	'a': {`[A-Za-z]`, "A"},
+	'w': {`[A-Za-z0-9]`, "X"},
+}
+
+// rePatternName matches the valid names of Pattern placeholders.
+var rePatternName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// patternDateNames maps placeholder names to the characters used to display them in error messages.
+var patternDateNames = map[string]string{
+	"year":  "Y",
+	"month": "M",
+	"day":   "D",
+}
+
+// Pattern returns a validation rule that checks if a string matches a template with typed placeholders,
+// such as "ORD-{year:4d}-{seq:6d}". A placeholder is written as {name:type}, where the type is "d" for digits,
+// "a" for letters or "w" for letters and digits, optionally preceded by the exact number of characters.
+// Without a number, one or more characters are accepted. All other text must match literally.
+//
+// The error message shows the template in a readable form, e.g. "must match ORD-YYYY-NNNNNN", where
+// digits are shown as N, letters as A and alphanumeric characters as X, except for placeholders named
+// year, month or day, which are shown as Y, M and D. Use Fields to extract the placeholder values.
+//
+// If the template cannot be parsed, the rule returns an internal error.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func Pattern(template string) PatternRule {
+	re, format, err := compilePattern(template)
+	return PatternRule{
+		re:         re,
+		format:     format,
+		patternErr: err,
+		err:        ErrPattern,
+	}
+}
+
+// PatternRule is a validation rule that checks if a string matches a template with typed placeholders.
+type PatternRule struct {
+	re         *regexp.Regexp
+	format     string
+	patternErr error
+	err        Error
+}
+
+// Validate checks if the given value is valid or not.
+func (r PatternRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	str, err := EnsureString(value)
+	if err != nil {
+		return err
+	}
+
+	if _, err := r.Fields(str); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Fields returns the values of the placeholders in the given string, indexed by placeholder name.
+// An error is returned if the string does not match the template.
+func (r PatternRule) Fields(value string) (map[string]string, error) {
+	if r.patternErr != nil {
+		return nil, NewInternalError(r.patternErr)
+	}
+
+	m := r.re.FindStringSubmatch(value)
+	if m == nil {
+		return nil, r.err.SetParams(map[string]interface{}{"format": r.format})
+	}
+
+	fields := map[string]string{}
+	for i, name := range r.re.SubexpNames() {
+		if name != "" {
+			fields[name] = m[i]
+		}
+	}
+	return fields, nil
+}
+
+// Error sets the error message for the rule.
+func (r PatternRule) Error(message string) PatternRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r PatternRule) ErrorObject(err Error) PatternRule {
+	r.err = err
+	return r
+}
+
+// compilePattern converts a Pattern template into an anchored regular expression and its display format.
+func compilePattern(template string) (*regexp.Regexp, string, error) {
+	var expr, format strings.Builder
+	names := map[string]bool{}
+
+	expr.WriteString("^")
+	for rest := template; rest != ""; {
+		start := strings.IndexByte(rest, '{')
+		if start < 0 {
+			expr.WriteString(regexp.QuoteMeta(rest))
+			format.WriteString(rest)
+			break
+		}
+		expr.WriteString(regexp.QuoteMeta(rest[:start]))
+		format.WriteString(rest[:start])
+
+		end := strings.IndexByte(rest[start:], '}')
+		if end < 0 {
+			return nil, "", fmt.Errorf("unclosed placeholder in pattern %q", template)
+		}
+		placeholder := rest[start+1 : start+end]
+		rest = rest[start+end+1:]
+
+		sep := strings.IndexByte(placeholder, ':')
+		if sep <= 0 || sep == len(placeholder)-1 {
+			return nil, "", fmt.Errorf("invalid placeholder {%v} in pattern %q", placeholder, template)
+		}
+		name, spec := placeholder[:sep], placeholder[sep+1:]
+		if names[name] || !rePatternName.MatchString(name) {
+			return nil, "", fmt.Errorf("invalid or duplicate placeholder name %q in pattern %q", name, template)
+		}
+		names[name] = true
+
+		class, ok := patternClasses[spec[len(spec)-1]]
+		if !ok {
+			return nil, "", fmt.Errorf("unknown placeholder type %q in pattern %q", spec[len(spec)-1:], template)
+		}
+		display := class.display
+		if d, ok := patternDateNames[name]; ok {
+			display = d
+		}
+
+		if count := spec[:len(spec)-1]; count == "" {
+			fmt.Fprintf(&expr, "(?P<%v>%v+)", name, class.class)
+			format.WriteString(display + "...")
+		} else {
+			n, err := strconv.Atoi(count)
+			if err != nil || n <= 0 {
+				return nil, "", fmt.Errorf("invalid placeholder length %q in pattern %q", count, template)
+			}
+			fmt.Fprintf(&expr, "(?P<%v>%v{%v})", name, class.class, n)
+			format.WriteString(strings.Repeat(display, n))
+		}
+	}
+	expr.WriteString("$")
+
+	re, err := regexp.Compile(expr.String())
+	if err != nil {
+		return nil, "", err
+	}
+	return re, format.String(), nil
+}
@@ -0,0 +1,73 @@
+package validate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPattern(t *testing.T) {
+	tests := []struct {
+		tag      string
+		template string
+		value    interface{}
+		err      string
+	}{
+		{"t1", "ORD-{year:4d}-{seq:6d}", "", ""},
+		{"t2", "ORD-{year:4d}-{seq:6d}", "ORD-2024-000123", ""},
+		{"t3", "ORD-{year:4d}-{seq:6d}", "ORD-24-000123", "must match ORD-YYYY-NNNNNN"},
+		{"t4", "ORD-{year:4d}-{seq:6d}", "ORD-2024-000123x", "must match ORD-YYYY-NNNNNN"},
+		{"t5", "ORD-{year:4d}-{seq:6d}", "xORD-2024-000123", "must match ORD-YYYY-NNNNNN"},
+		{"t6", "{cc:2a}.{id:w}", "DE.a1b2", ""},
+		{"t7", "{cc:2a}.{id:w}", "DE.", "must match AA.X..."},
+		{"t8", "{cc:2a}.{id:w}", "D1.a1", "must match AA.X..."},
+		{"t9", "v{n:d}", "v12", ""},
+		{"t10", "a.b({n:d})", "a.b(1)", ""},
+		{"t11", "a.b({n:d})", "axb(1)", "must match a.b(N...)"},
+		{"t12", "ORD-{year:4d}", 2024, "must be either a string or byte slice"},
+		{"t13", "ORD-{year:4d", "ORD-2024", "unclosed placeholder in pattern \"ORD-{year:4d\""},
+		{"t14", "ORD-{year}", "ORD-2024", "invalid placeholder {year} in pattern \"ORD-{year}\""},
+		{"t15", "{a:1d}{a:1d}", "12", "invalid or duplicate placeholder name \"a\" in pattern \"{a:1d}{a:1d}\""},
+		{"t16", "{a:4x}", "1234", "unknown placeholder type \"x\" in pattern \"{a:4x}\""},
+		{"t17", "{a:0d}", "1", "invalid placeholder length \"0\" in pattern \"{a:0d}\""},
+	}
+
+	for _, test := range tests {
+		r := Pattern(test.template)
+		err := r.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestPatternRule_Fields(t *testing.T) {
+	r := Pattern("ORD-{year:4d}-{seq:6d}")
+
+	fields, err := r.Fields("ORD-2024-000123")
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]string{"year": "2024", "seq": "000123"}, fields)
+
+	fields, err = r.Fields("ORD-2024")
+	assert.EqualError(t, err, "must match ORD-YYYY-NNNNNN")
+	assert.Nil(t, fields)
+
+	_, err = Pattern("{x}").Fields("1")
+	if assert.NotNil(t, err) {
+		_, ok := err.(InternalError)
+		assert.True(t, ok)
+	}
+}
+
+func TestPatternRule_Error(t *testing.T) {
+	r := Pattern("ORD-{seq:2d}").Error("expected {{.format}}")
+	assert.Equal(t, "expected ORD-NN", r.Validate("ORD-1").Error())
+}
+
+func TestPatternRule_ErrorObject(t *testing.T) {
+	r := Pattern("a")
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
@@ -0,0 +1,72 @@
+// Package phone provides validation rules for phone numbers. It is kept separate from the validate package
+// so that only the users of these rules depend on github.com/nyaruka/phonenumbers and its metadata.
+package phone
+
+import (
+	"strings"
+
+	"github.com/nanoteck137/validate"
+	"github.com/nyaruka/phonenumbers"
+)
+
+// ErrForRegion is the error that returns when a value is not a valid phone number for a region.
+var ErrForRegion = validate.NewError("validation_phone_for_region", "must be a valid {{.region}} phone number")
+
+// ForRegion returns a validation rule that checks if a string is a valid phone number for the given region.
+// The region should be specified as a two-letter ISO 3166 country code, e.g. "US" or "GB".
+// Numbers may be given either in the national format of the region or in the international format,
+// but in the latter case they must still belong to the region.
+// Use Normalize to convert a valid number into the E.164 format for storage.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func ForRegion(region string) Rule {
+	return Rule{
+		region: strings.ToUpper(region),
+		err:    ErrForRegion,
+	}
+}
+
+// Rule is a validation rule that checks if a string is a valid phone number for a region.
+type Rule struct {
+	region string
+	err    validate.Error
+}
+
+// Validate checks if the given value is valid or not.
+func (r Rule) Validate(value interface{}) error {
+	value, isNil := validate.Indirect(value)
+	if isNil || validate.IsEmpty(value) {
+		return nil
+	}
+
+	str, err := validate.EnsureString(value)
+	if err != nil {
+		return err
+	}
+
+	if _, err := r.Normalize(str); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Normalize returns the given phone number in the E.164 format.
+// An error is returned if the number is not a valid phone number for the region.
+func (r Rule) Normalize(value string) (string, error) {
+	num, err := phonenumbers.Parse(value, r.region)
+	if err != nil || !phonenumbers.IsValidNumberForRegion(num, r.region) {
+		return "", r.err.SetParams(map[string]interface{}{"region": r.region})
+	}
+	return phonenumbers.Format(num, phonenumbers.E164), nil
+}
+
+// Error sets the error message for the rule.
+func (r Rule) Error(message string) Rule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r Rule) ErrorObject(err validate.Error) Rule {
+	r.err = err
+	return r
+}
@@ -0,0 +1,71 @@
+package phone
+
+import (
+	"testing"
+
+	"github.com/nanoteck137/validate"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestForRegion(t *testing.T) {
+	tests := []struct {
+		tag    string
+		region string
+		value  interface{}
+		err    string
+	}{
+		{"t1", "US", "", ""},
+		{"t2", "US", "(415) 555-2671", ""},
+		{"t3", "US", "415-555-2671", ""},
+		{"t4", "US", "+1 415 555 2671", ""},
+		{"t5", "US", "555-2671", "must be a valid US phone number"},
+		{"t6", "US", "+44 20 7946 0958", "must be a valid US phone number"},
+		{"t7", "gb", "020 7946 0958", ""},
+		{"t8", "US", "abc", "must be a valid US phone number"},
+		{"t9", "US", 4155552671, "must be either a string or byte slice"},
+	}
+
+	for _, test := range tests {
+		r := ForRegion(test.region)
+		err := r.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestRule_Normalize(t *testing.T) {
+	r := ForRegion("US")
+
+	n, err := r.Normalize("(415) 555-2671")
+	assert.Nil(t, err)
+	assert.Equal(t, "+14155552671", n)
+
+	n, err = r.Normalize("555-2671")
+	assert.EqualError(t, err, "must be a valid US phone number")
+	assert.Equal(t, "", n)
+}
+
+func TestRule_Error(t *testing.T) {
+	r := ForRegion("US")
+	assert.Equal(t, "must be a valid US phone number", r.Validate("123").Error())
+
+	r = r.Error("invalid number for {{.region}}")
+	assert.Equal(t, "invalid number for US", r.Validate("123").Error())
+}
+
+func TestRule_ErrorObject(t *testing.T) {
+	r := ForRegion("US")
+	err := validate.NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
+
+func assertError(t *testing.T, expected string, err error, tag string) {
+	if expected == "" {
+		assert.NoError(t, err, tag)
+	} else {
+		assert.EqualError(t, err, expected, tag)
+	}
+}
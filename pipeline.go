@@ -0,0 +1,36 @@
+package validate
+
+// PipelineStage represents a single step of a Pipeline.
+// It receives the output of the previous stage (or the value being validated for the first stage)
+// and returns the value to pass to the next stage, or an error if validation fails.
+type PipelineStage func(prev interface{}) (interface{}, error)
+
+// Pipeline returns a validation rule that runs the given stages in order, passing the output of each
+// stage to the next one. This allows transforming a value before validating it further, e.g. parsing
+// a string and then range-checking the parsed result:
+//    validation.Pipeline(
+//        func(v interface{}) (interface{}, error) { return strconv.Atoi(v.(string)) },
+//        func(v interface{}) (interface{}, error) { return v, validation.Validate(v, validation.Min(1)) },
+//    )
+//
+// Validation stops at the first stage returning an error, and that error is returned as is.
+// Unlike most rules, empty values are passed to the stages as well.
+func Pipeline(stages ...PipelineStage) PipelineRule {
+	return PipelineRule{stages: stages}
+}
+
+// PipelineRule is a validation rule that runs a chain of transforming validation stages.
+type PipelineRule struct {
+	stages []PipelineStage
+}
+
+// Validate checks if the given value is valid or not.
+func (r PipelineRule) Validate(value interface{}) error {
+	var err error
+	for _, stage := range r.stages {
+		if value, err = stage(value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package validate
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPipeline(t *testing.T) {
+	parse := func(v interface{}) (interface{}, error) {
+		n, err := strconv.Atoi(v.(string))
+		if err != nil {
+			return nil, errors.New("must be a number")
+		}
+		return n, nil
+	}
+	positive := func(v interface{}) (interface{}, error) {
+		return v, Validate(v, Min(1))
+	}
+	double := func(v interface{}) (interface{}, error) {
+		return v.(int) * 2, nil
+	}
+	atMost10 := func(v interface{}) (interface{}, error) {
+		return v, Validate(v, Max(10))
+	}
+
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", "3", ""},
+		{"t2", "abc", "must be a number"},
+		{"t3", "-1", "must be no less than 1"},
+		{"t4", "6", "must be no greater than 10"},
+		{"t5", "5", ""},
+	}
+
+	r := Pipeline(parse, positive, double, atMost10)
+	for _, test := range tests {
+		err := Validate(test.value, r)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	assert.Nil(t, Pipeline().Validate("abc"))
+
+	calls := 0
+	stop := Pipeline(
+		func(v interface{}) (interface{}, error) { return nil, errors.New("stop") },
+		func(v interface{}) (interface{}, error) { calls++; return v, nil },
+	)
+	assert.EqualError(t, stop.Validate(""), "stop")
+	assert.Equal(t, 0, calls)
+}
@@ -0,0 +1,96 @@
+package validate
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// reDecimal matches a plain decimal number without an exponent, e.g. "-12.50".
+var reDecimal = regexp.MustCompile(`^[+-]?\d+(\.\d+)?$`)
+
+// ErrPrecisionExceeded is the error that returns when a number has more decimal places than declared.
+var ErrPrecisionExceeded = NewError("validation_precision_exceeded", "value exceeds the declared precision")
+
+// PrecisionFromField returns a validation rule that checks if a number has at most as many decimal places
+// as the integer referenced by precisionPtr. precisionPtr should point to a sibling field, which makes
+// the rule usable within ValidateStruct:
+//    validation.Field(&r.Value, validation.PrecisionFromField(&r.Precision))
+//
+// The value may be an int, uint or float, or a string holding a plain decimal number such as "-12.50";
+// strings in other forms, e.g. with an exponent, as well as NaN and infinite floats result in an error.
+// Floats are checked using their shortest decimal representation. If precisionPtr is nil or points to a nil pointer,
+// the rule is skipped. A negative precision results in an internal error.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func PrecisionFromField(precisionPtr interface{}) PrecisionRule {
+	return PrecisionRule{
+		precision: precisionPtr,
+		err:       ErrPrecisionExceeded,
+	}
+}
+
+// PrecisionRule is a validation rule that checks the number of decimal places against a sibling field.
+type PrecisionRule struct {
+	precision interface{}
+	err       Error
+}
+
+// Validate checks if the given value is valid or not.
+func (r PrecisionRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	pv, isNil := Indirect(r.precision)
+	if isNil {
+		return nil
+	}
+	precision, err := ToInt(pv)
+	if err != nil {
+		return err
+	}
+	if precision < 0 {
+		return NewInternalError(fmt.Errorf("precision must not be negative, got %v", precision))
+	}
+
+	var digits string
+	switch v := reflect.ValueOf(value); v.Kind() {
+	case reflect.Float32, reflect.Float64:
+		f := v.Float()
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return fmt.Errorf("cannot check the precision of %v", f)
+		}
+		digits = strconv.FormatFloat(f, 'f', -1, v.Type().Bits())
+	case reflect.String:
+		if !reDecimal.MatchString(v.String()) {
+			return fmt.Errorf("cannot convert %q to a decimal number", v.String())
+		}
+		digits = v.String()
+	default:
+		if _, err := ToNumber(value); err != nil {
+			return err
+		}
+		return nil
+	}
+
+	if dot := strings.IndexByte(digits, '.'); dot >= 0 && int64(len(strings.TrimRight(digits[dot+1:], "0"))) > precision {
+		return r.err.SetParams(map[string]interface{}{"precision": precision})
+	}
+	return nil
+}
+
+// Error sets the error message for the rule.
+func (r PrecisionRule) Error(message string) PrecisionRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r PrecisionRule) ErrorObject(err Error) PrecisionRule {
+	r.err = err
+	return r
+}
@@ -0,0 +1,84 @@
+package validate
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrecisionFromField(t *testing.T) {
+	two, zero, negative := 2, 0, -1
+	var nilPrecision *int
+
+	tests := []struct {
+		tag       string
+		precision interface{}
+		value     interface{}
+		err       string
+	}{
+		{"t1", &two, 0.0, ""},
+		{"t2", &two, 1.25, ""},
+		{"t3", &two, 1.255, "value exceeds the declared precision"},
+		{"t4", &two, float32(1.5), ""},
+		{"t5", &two, "1.2500", ""},
+		{"t6", &two, "1.257", "value exceeds the declared precision"},
+		{"t7", &two, "abc", "cannot convert \"abc\" to a decimal number"},
+		{"t8", &two, 12, ""},
+		{"t9", &zero, 12.0, ""},
+		{"t10", &zero, 12.5, "value exceeds the declared precision"},
+		{"t11", nilPrecision, 12.555, ""},
+		{"t12", nil, 12.555, ""},
+		{"t13", &negative, 1.5, "precision must not be negative, got -1"},
+		{"t14", &two, true, "cannot convert bool to a number"},
+		{"t15", "2", 1.5, "cannot convert string to int64"},
+		{"t16", &zero, "1e-5", "cannot convert \"1e-5\" to a decimal number"},
+		{"t17", &two, "1.5e3", "cannot convert \"1.5e3\" to a decimal number"},
+		{"t18", &two, "NaN", "cannot convert \"NaN\" to a decimal number"},
+		{"t19", &two, "Inf", "cannot convert \"Inf\" to a decimal number"},
+		{"t20", &two, "0x1p-2", "cannot convert \"0x1p-2\" to a decimal number"},
+		{"t21", &two, "-12.50", ""},
+		{"t22", &two, "+3", ""},
+		{"t23", &two, "1.", "cannot convert \"1.\" to a decimal number"},
+		{"t24", &two, math.NaN(), "cannot check the precision of NaN"},
+		{"t25", &two, math.Inf(1), "cannot check the precision of +Inf"},
+		{"t26", &two, float32(math.Inf(-1)), "cannot check the precision of -Inf"},
+		{"t27", &zero, 1e-5, "value exceeds the declared precision"},
+		{"t28", &zero, 1.5e3, ""},
+	}
+
+	for _, test := range tests {
+		r := PrecisionFromField(test.precision)
+		err := r.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestPrecisionFromField_ValidateStruct(t *testing.T) {
+	m := struct {
+		Value     float64
+		Precision int
+	}{1.234, 2}
+
+	err := ValidateStruct(&m, Field(&m.Value, PrecisionFromField(&m.Precision)))
+	assert.EqualError(t, err, "Value: value exceeds the declared precision.")
+
+	m.Precision = 3
+	assert.Nil(t, ValidateStruct(&m, Field(&m.Value, PrecisionFromField(&m.Precision))))
+}
+
+func TestPrecisionRule_Error(t *testing.T) {
+	one := 1
+	r := PrecisionFromField(&one).Error("at most {{.precision}} decimals")
+	assert.Equal(t, "at most 1 decimals", r.Validate(1.25).Error())
+}
+
+func TestPrecisionRule_ErrorObject(t *testing.T) {
+	r := PrecisionFromField(nil)
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
@@ -0,0 +1,89 @@
+package validate
+
+import "fmt"
+
+// ErrIntPredicate is the error that returns when an integer value does not satisfy an IntPredicate rule.
+var ErrIntPredicate = NewError("validation_predicate", "is invalid")
+
+// ErrPowerOfTwo is the error that returns when a value is not a power of two.
+var ErrPowerOfTwo = NewError("validation_power_of_two", "must be a power of two")
+
+// IntPredicateRule is a rule that checks an integer value using a specified predicate function.
+type IntPredicateRule struct {
+	predicate     func(int64) bool
+	uintPredicate func(uint64) bool
+	err           Error
+}
+
+// IntPredicate returns a validation rule that checks an integer value using the given predicate.
+// The rule fails with ErrIntPredicate using the given message if the predicate returns false.
+// Only int and uint types are supported. uint values that overflow int64 result in an internal error.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func IntPredicate(predicate func(int64) bool, message string) IntPredicateRule {
+	return IntPredicateRule{
+		predicate: predicate,
+		err:       ErrIntPredicate.SetMessage(message),
+	}
+}
+
+// PowerOfTwo returns a validation rule that checks if an integer value is a power of two.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func PowerOfTwo() IntPredicateRule {
+	return IntPredicateRule{
+		predicate:     isPowerOfTwo,
+		uintPredicate: isUintPowerOfTwo,
+		err:           ErrPowerOfTwo,
+	}
+}
+
+// Validate checks if the given value is valid or not.
+func (r IntPredicateRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	v, err := toInt64(value)
+	if err == errIntOverflow {
+		return r.validateUint(value)
+	} else if err != nil {
+		return err
+	}
+
+	if r.predicate(v) {
+		return nil
+	}
+	return r.err
+}
+
+// validateUint checks a uint value that overflows int64 using the uint predicate, if the rule has one.
+func (r IntPredicateRule) validateUint(value interface{}) error {
+	if r.uintPredicate == nil {
+		return NewInternalError(fmt.Errorf("cannot check %v with an int64 predicate", value))
+	}
+	u, _ := ToUint(value)
+	if r.uintPredicate(u) {
+		return nil
+	}
+	return r.err
+}
+
+// Error sets the error message for the rule.
+func (r IntPredicateRule) Error(message string) IntPredicateRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r IntPredicateRule) ErrorObject(err Error) IntPredicateRule {
+	r.err = err
+	return r
+}
+
+func isPowerOfTwo(v int64) bool {
+	return v > 0 && v&(v-1) == 0
+}
+
+func isUintPowerOfTwo(v uint64) bool {
+	return v > 0 && v&(v-1) == 0
+}
@@ -0,0 +1,71 @@
+package validate
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPowerOfTwo(t *testing.T) {
+	var nilPtr *int
+	v := 4096
+
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", 0, ""},
+		{"t2", 1, ""},
+		{"t3", 2, ""},
+		{"t4", 1024, ""},
+		{"t5", 3, "must be a power of two"},
+		{"t6", -2, "must be a power of two"},
+		{"t7", uint32(64), ""},
+		{"t8", uint64(100), "must be a power of two"},
+		{"t9", uint64(math.MaxUint64), "must be a power of two"},
+		{"t10", &v, ""},
+		{"t11", nilPtr, ""},
+		{"t12", 2.0, "cannot convert float64 to int64"},
+		{"t13", uint64(1) << 63, ""},
+		{"t14", uint64(1)<<63 + 2, "must be a power of two"},
+	}
+
+	for _, test := range tests {
+		err := PowerOfTwo().Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestIntPredicate(t *testing.T) {
+	even := IntPredicate(func(v int64) bool { return v%2 == 0 }, "must be even")
+	assert.Nil(t, even.Validate(4))
+	assert.Nil(t, even.Validate(uint8(8)))
+	assert.EqualError(t, even.Validate(5), "must be even")
+	assert.EqualError(t, even.Validate("5"), "cannot convert string to int64")
+
+	err := even.Validate(5).(Error)
+	assert.Equal(t, "validation_predicate", err.Code())
+
+	_, ok := even.Validate(uint64(math.MaxUint64)).(InternalError)
+	assert.True(t, ok)
+}
+
+func TestIntPredicateRule_Error(t *testing.T) {
+	r := PowerOfTwo()
+	assert.Equal(t, "must be a power of two", r.Validate(3).Error())
+
+	r = r.Error("123")
+	assert.Equal(t, "123", r.err.Message())
+}
+
+func TestIntPredicateRule_ErrorObject(t *testing.T) {
+	r := PowerOfTwo()
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
@@ -0,0 +1,68 @@
+package validate
+
+// ErrPrefixSumMax is the error that returns when the running total of a slice exceeds a limit.
+var ErrPrefixSumMax = NewError("validation_prefix_sum_max", "cumulative total exceeds {{.max}} at item {{.index}}")
+
+// PrefixSumMax returns a validation rule that checks if the running total of a slice or array never exceeds max.
+// The extractor function returns the amount contributed by each element. If it is nil, the elements
+// themselves must be of int, uint or float types.
+// The rule fails at the first (zero-based) index where the running total exceeds max, which is
+// reported in the "index" parameter of the error.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func PrefixSumMax(max float64, extractor func(interface{}) float64) PrefixSumRule {
+	return PrefixSumRule{
+		max:       max,
+		extractor: extractor,
+		err:       ErrPrefixSumMax,
+	}
+}
+
+// PrefixSumRule is a validation rule that checks if the running total of a slice never exceeds a limit.
+type PrefixSumRule struct {
+	max       float64
+	extractor func(interface{}) float64
+	err       Error
+}
+
+// Validate checks if the given value is valid or not.
+func (r PrefixSumRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	v, err := sliceValue(value)
+	if err != nil {
+		return err
+	}
+
+	var total float64
+	for i := 0; i < v.Len(); i++ {
+		e := v.Index(i).Interface()
+		if r.extractor != nil {
+			total += r.extractor(e)
+		} else {
+			n, err := ToNumber(e)
+			if err != nil {
+				return err
+			}
+			total += n
+		}
+		if total > r.max {
+			return r.err.SetParams(map[string]interface{}{"max": r.max, "index": i})
+		}
+	}
+	return nil
+}
+
+// Error sets the error message for the rule.
+func (r PrefixSumRule) Error(message string) PrefixSumRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r PrefixSumRule) ErrorObject(err Error) PrefixSumRule {
+	r.err = err
+	return r
+}
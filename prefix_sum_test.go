@@ -0,0 +1,56 @@
+package validate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrefixSumMax(t *testing.T) {
+	type allocation struct {
+		Amount float64
+	}
+	amount := func(v interface{}) float64 { return v.(allocation).Amount }
+
+	tests := []struct {
+		tag       string
+		extractor func(interface{}) float64
+		value     interface{}
+		err       string
+	}{
+		{"t1", nil, []int{}, ""},
+		{"t2", nil, []int(nil), ""},
+		{"t3", nil, []int{10, 20, 70}, ""},
+		{"t4", nil, []int{50, 40, 5, 6, 1}, "cumulative total exceeds 100 at item 3"},
+		{"t5", nil, [3]float64{99.5, 0.5, 0.1}, "cumulative total exceeds 100 at item 2"},
+		{"t6", nil, []int{150, -100}, "cumulative total exceeds 100 at item 0"},
+		{"t7", amount, []allocation{{60}, {40}}, ""},
+		{"t8", amount, []allocation{{60}, {41}}, "cumulative total exceeds 100 at item 1"},
+		{"t9", nil, []string{"a"}, "cannot convert string to a number"},
+		{"t10", nil, 100, "must be a slice or an array"},
+	}
+
+	for _, test := range tests {
+		r := PrefixSumMax(100, test.extractor)
+		err := r.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestPrefixSumRule_Error(t *testing.T) {
+	r := PrefixSumMax(1, nil)
+	assert.Equal(t, "cumulative total exceeds 1 at item 1", r.Validate([]int{1, 1}).Error())
+
+	r = r.Error("budget exceeded at {{.index}}")
+	assert.Equal(t, "budget exceeded at 1", r.Validate([]int{1, 1}).Error())
+}
+
+func TestPrefixSumRule_ErrorObject(t *testing.T) {
+	r := PrefixSumMax(1, nil)
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
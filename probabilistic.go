@@ -0,0 +1,57 @@
+package validate
+
+import "errors"
+
+// ErrNotRecognized is the error that returns when a value is not a member of a set.
+var ErrNotRecognized = NewError("validation_not_recognized", "value is not recognized")
+
+// ProbabilisticMember returns a validation rule that checks if a value is a member of a set using the given
+// membership function, which may be backed by a probabilistic structure such as a bloom filter for sets that
+// are too large to be held in memory. The function is called with the dereferenced value.
+//
+// With a bloom filter, the rule inherits its semantics: there are no false negatives, so members of the set
+// always pass, but values that are not members pass with the false-positive probability of the filter.
+// The rule should therefore be used to reject values early, not as proof of membership.
+// A nil membership function results in an internal error.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func ProbabilisticMember(contains func(value interface{}) bool) ProbabilisticMemberRule {
+	return ProbabilisticMemberRule{
+		contains: contains,
+		err:      ErrNotRecognized,
+	}
+}
+
+// ProbabilisticMemberRule is a validation rule that checks if a value is a member of a set.
+type ProbabilisticMemberRule struct {
+	contains func(value interface{}) bool
+	err      Error
+}
+
+// Validate checks if the given value is valid or not.
+func (r ProbabilisticMemberRule) Validate(value interface{}) error {
+	if r.contains == nil {
+		return NewInternalError(errors.New("membership function must not be nil"))
+	}
+
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	if r.contains(value) {
+		return nil
+	}
+	return r.err
+}
+
+// Error sets the error message for the rule.
+func (r ProbabilisticMemberRule) Error(message string) ProbabilisticMemberRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r ProbabilisticMemberRule) ErrorObject(err Error) ProbabilisticMemberRule {
+	r.err = err
+	return r
+}
@@ -0,0 +1,82 @@
+package validate
+
+import (
+	"hash/fnv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testBloomFilter is a minimal bloom filter with two hash functions over a 64-bit set.
+type testBloomFilter uint64
+
+func (f testBloomFilter) positions(s string) (uint, uint) {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(s))
+	sum := h.Sum64()
+	return uint(sum % 64), uint((sum >> 32) % 64)
+}
+
+func (f *testBloomFilter) add(s string) {
+	a, b := f.positions(s)
+	*f |= 1<<a | 1<<b
+}
+
+func (f testBloomFilter) contains(value interface{}) bool {
+	s, ok := value.(string)
+	if !ok {
+		return false
+	}
+	a, b := f.positions(s)
+	return f&(1<<a) != 0 && f&(1<<b) != 0
+}
+
+func TestProbabilisticMember(t *testing.T) {
+	var filter testBloomFilter
+	members := []string{"alice", "bob", "carol"}
+	for _, m := range members {
+		filter.add(m)
+	}
+	r := ProbabilisticMember(filter.contains)
+
+	// members always pass
+	for _, m := range members {
+		assert.Nil(t, r.Validate(m), m)
+		assert.Nil(t, r.Validate(&m), m)
+	}
+
+	var nilPtr *string
+	assert.Nil(t, r.Validate(""))
+	assert.Nil(t, r.Validate(nilPtr))
+	assert.EqualError(t, r.Validate(42), "value is not recognized")
+
+	// non-members are rejected unless they are false positives
+	rejected := 0
+	for _, s := range []string{"dave", "erin", "frank", "grace", "heidi", "ivan", "judy", "mallory"} {
+		if r.Validate(s) != nil {
+			rejected++
+		}
+	}
+	assert.True(t, rejected > 0)
+
+	err := ProbabilisticMember(nil).Validate("alice")
+	if assert.NotNil(t, err) {
+		_, ok := err.(InternalError)
+		assert.True(t, ok)
+	}
+}
+
+func TestProbabilisticMemberRule_Error(t *testing.T) {
+	r := ProbabilisticMember(func(interface{}) bool { return false }).Error("unknown user")
+	assert.Equal(t, "unknown user", r.Validate("x").Error())
+}
+
+func TestProbabilisticMemberRule_ErrorObject(t *testing.T) {
+	r := ProbabilisticMember(nil)
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
@@ -0,0 +1,84 @@
+package validate
+
+import "math"
+
+var (
+	// ErrProbabilitySum is the error that returns when the weights of a distribution do not sum to 1.
+	ErrProbabilitySum = NewError("validation_probability_sum", "weights must sum to 1.0")
+	// ErrProbabilityRange is the error that returns when a weight of a distribution is not between 0 and 1.
+	ErrProbabilityRange = NewError("validation_probability_range", "weight at index {{.index}} must be between 0 and 1")
+)
+
+// ProbabilityDistribution returns a validation rule that checks if a slice or array of numbers forms a valid
+// probability distribution, i.e. every weight is between 0 and 1 and the weights sum to 1 within
+// the given tolerance. The elements must be of int, uint or float types.
+// The range of each weight is checked first and the first out-of-range (zero-based) index is reported in
+// the "index" parameter of the error, so the two conditions can be told apart by their error codes.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func ProbabilityDistribution(tolerance float64) ProbabilityRule {
+	return ProbabilityRule{
+		tolerance: tolerance,
+		err:       ErrProbabilitySum,
+		rangeErr:  ErrProbabilityRange,
+	}
+}
+
+// ProbabilityRule is a validation rule that checks if a slice of weights forms a probability distribution.
+type ProbabilityRule struct {
+	tolerance     float64
+	err, rangeErr Error
+}
+
+// Validate checks if the given value is valid or not.
+func (r ProbabilityRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	v, err := sliceValue(value)
+	if err != nil {
+		return err
+	}
+
+	var sum float64
+	for i := 0; i < v.Len(); i++ {
+		n, err := ToNumber(v.Index(i).Interface())
+		if err != nil {
+			return err
+		}
+		if !(n >= 0 && n <= 1) {
+			return r.rangeErr.SetParams(map[string]interface{}{"index": i})
+		}
+		sum += n
+	}
+
+	if math.Abs(sum-1) > r.tolerance {
+		return r.err
+	}
+	return nil
+}
+
+// Error sets the error message that is used when the weights do not sum to 1.
+func (r ProbabilityRule) Error(message string) ProbabilityRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct that is used when the weights do not sum to 1.
+func (r ProbabilityRule) ErrorObject(err Error) ProbabilityRule {
+	r.err = err
+	return r
+}
+
+// RangeError sets the error message that is used when a weight is not between 0 and 1.
+func (r ProbabilityRule) RangeError(message string) ProbabilityRule {
+	r.rangeErr = r.rangeErr.SetMessage(message)
+	return r
+}
+
+// RangeErrorObject sets the error struct that is used when a weight is not between 0 and 1.
+func (r ProbabilityRule) RangeErrorObject(err Error) ProbabilityRule {
+	r.rangeErr = err
+	return r
+}
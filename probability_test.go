@@ -0,0 +1,55 @@
+package validate
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProbabilityDistribution(t *testing.T) {
+	var nilSlice []float64
+
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", nilSlice, ""},
+		{"t2", []float64{}, ""},
+		{"t3", []float64{0.2, 0.3, 0.5}, ""},
+		{"t4", []float64{0.1, 0.2, 0.7}, ""},
+		{"t5", []float64{1}, ""},
+		{"t6", []float64{0.2, 0.3}, "weights must sum to 1.0"},
+		{"t7", []float64{0.5, 0.5, 0.01}, "weights must sum to 1.0"},
+		{"t8", []float64{0.5, 0.5005}, ""},
+		{"t9", []float64{1.5, -0.5}, "weight at index 0 must be between 0 and 1"},
+		{"t10", []float64{0.5, -0.5, 1}, "weight at index 1 must be between 0 and 1"},
+		{"t11", []float64{math.NaN()}, "weight at index 0 must be between 0 and 1"},
+		{"t12", [2]int{0, 1}, ""},
+		{"t13", []string{"a"}, "cannot convert string to a number"},
+		{"t14", 0.5, "must be a slice or an array"},
+	}
+
+	for _, test := range tests {
+		err := ProbabilityDistribution(0.001).Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestProbabilityRule_Error(t *testing.T) {
+	r := ProbabilityDistribution(0).Error("123").RangeError("bad weight {{.index}}")
+	assert.Equal(t, "123", r.Validate([]float64{0.5}).Error())
+	assert.Equal(t, "bad weight 0", r.Validate([]float64{2}).Error())
+}
+
+func TestProbabilityRule_ErrorObject(t *testing.T) {
+	r := ProbabilityDistribution(0)
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err).RangeErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err, r.rangeErr)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
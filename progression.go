@@ -0,0 +1,91 @@
+package validate
+
+import "math"
+
+var (
+	// ErrArithmeticSequence is the error that returns when numbers do not form an arithmetic sequence.
+	ErrArithmeticSequence = NewError("validation_arithmetic_sequence", "values must form an arithmetic sequence")
+	// ErrGeometricSequence is the error that returns when numbers do not form a geometric sequence.
+	ErrGeometricSequence = NewError("validation_geometric_sequence", "values must form a geometric sequence")
+)
+
+// ArithmeticSequence returns a validation rule that checks if a slice or array of numbers has a constant
+// difference between consecutive elements, which is the difference between the first two elements.
+// Element i deviates if |a[i] - a[i-1] - d| > tolerance. The (zero-based) index of the first deviating
+// element is reported in the "index" parameter of the error. The elements must be of int, uint or float types.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func ArithmeticSequence(tolerance float64) ProgressionRule {
+	return ProgressionRule{
+		tolerance: tolerance,
+		err:       ErrArithmeticSequence,
+	}
+}
+
+// GeometricSequence returns a validation rule that checks if a slice or array of numbers has a constant
+// ratio between consecutive elements, which is the ratio between the first two elements.
+// Element i deviates if |a[i] - a[i-1]*q| > tolerance. If the first element is zero, all elements must be zero.
+// The (zero-based) index of the first deviating element is reported in the "index" parameter of the error.
+// The elements must be of int, uint or float types.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func GeometricSequence(tolerance float64) ProgressionRule {
+	return ProgressionRule{
+		tolerance: tolerance,
+		geometric: true,
+		err:       ErrGeometricSequence,
+	}
+}
+
+// ProgressionRule is a validation rule that checks if numbers form an arithmetic or geometric sequence.
+type ProgressionRule struct {
+	tolerance float64
+	geometric bool
+	err       Error
+}
+
+// Validate checks if the given value is valid or not.
+func (r ProgressionRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	v, err := sliceValue(value)
+	if err != nil {
+		return err
+	}
+
+	values := make([]float64, v.Len())
+	for i := range values {
+		if values[i], err = ToNumber(v.Index(i).Interface()); err != nil {
+			return err
+		}
+	}
+
+	for i := 1; i < len(values); i++ {
+		var expected float64
+		switch {
+		case !r.geometric:
+			expected = values[i-1] + values[1] - values[0]
+		case values[0] == 0:
+			expected = 0
+		default:
+			expected = values[i-1] * values[1] / values[0]
+		}
+		if !(math.Abs(values[i]-expected) <= r.tolerance) {
+			return r.err.SetParams(map[string]interface{}{"index": i})
+		}
+	}
+	return nil
+}
+
+// Error sets the error message for the rule.
+func (r ProgressionRule) Error(message string) ProgressionRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r ProgressionRule) ErrorObject(err Error) ProgressionRule {
+	r.err = err
+	return r
+}
@@ -0,0 +1,78 @@
+package validate
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArithmeticSequence(t *testing.T) {
+	var nilSlice []float64
+
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", nilSlice, ""},
+		{"t2", []float64{}, ""},
+		{"t3", []float64{5}, ""},
+		{"t4", []float64{1, 3, 5, 7}, ""},
+		{"t5", []float64{0.1, 0.2, 0.3, 0.4}, ""},
+		{"t6", []float64{10, 7, 4, 1, -2}, ""},
+		{"t7", []float64{1, 3, 5, 8, 9}, "values must form an arithmetic sequence"},
+		{"t8", []int{2, 2, 2}, ""},
+		{"t9", []float64{1, 2, math.NaN()}, "values must form an arithmetic sequence"},
+		{"t10", []string{"a"}, "cannot convert string to a number"},
+		{"t11", 1.5, "must be a slice or an array"},
+	}
+
+	for _, test := range tests {
+		err := ArithmeticSequence(1e-9).Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestGeometricSequence(t *testing.T) {
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", []float64{}, ""},
+		{"t2", []float64{3}, ""},
+		{"t3", []float64{1, 2, 4, 8}, ""},
+		{"t4", []float64{81, 27, 9, 3, 1}, ""},
+		{"t5", []float64{1, -2, 4, -8}, ""},
+		{"t6", []float64{1, 2, 4, 9}, "values must form a geometric sequence"},
+		{"t7", []float64{0, 0, 0}, ""},
+		{"t8", []float64{0, 1}, "values must form a geometric sequence"},
+		{"t9", []float64{2, 0, 0}, ""},
+		{"t10", []float64{2, 0, 1}, "values must form a geometric sequence"},
+	}
+
+	for _, test := range tests {
+		err := GeometricSequence(1e-9).Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestProgressionRule_Error(t *testing.T) {
+	r := ArithmeticSequence(0).Error("item {{.index}} breaks the sequence")
+	assert.Equal(t, "item 2 breaks the sequence", r.Validate([]int{1, 2, 4}).Error())
+
+	r = GeometricSequence(0.5).Error("item {{.index}} breaks the sequence")
+	assert.Nil(t, r.Validate([]float64{1, 2, 4.4}))
+	assert.Equal(t, "item 2 breaks the sequence", r.Validate([]float64{1, 2, 5}).Error())
+}
+
+func TestProgressionRule_ErrorObject(t *testing.T) {
+	r := ArithmeticSequence(0)
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
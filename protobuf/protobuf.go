@@ -0,0 +1,76 @@
+// Package protobuf provides validation rules for protobuf messages. It is kept separate from the validate
+// package so that only the users of these rules depend on google.golang.org/protobuf.
+package protobuf
+
+import (
+	"encoding/base64"
+
+	"github.com/nanoteck137/validate"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// ErrMessage is the error that returns when a value cannot be decoded as a protobuf message.
+var ErrMessage = validate.NewError("validation_protobuf", "must be a valid {{.message}}")
+
+// Message returns a validation rule that checks if a value decodes to a protobuf message of the type described
+// by the given descriptor. A string is expected to hold the base64-encoded (standard encoding with padding)
+// wire format, while a byte slice is decoded as the wire format directly. Decoding fails on malformed input
+// and on missing required fields. The descriptor can be obtained from a generated message type, e.g.
+//    protobuf.Message((&pb.Order{}).ProtoReflect().Descriptor())
+//
+// The name of the message type is reported in the "message" parameter of the error.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func Message(descriptor protoreflect.MessageDescriptor) MessageRule {
+	return MessageRule{
+		descriptor: descriptor,
+		err:        ErrMessage,
+	}
+}
+
+// MessageRule is a validation rule that checks if a value decodes to a protobuf message.
+type MessageRule struct {
+	descriptor protoreflect.MessageDescriptor
+	err        validate.Error
+}
+
+// Validate checks if the given value is valid or not.
+func (r MessageRule) Validate(value interface{}) error {
+	value, isNil := validate.Indirect(value)
+	if isNil || validate.IsEmpty(value) {
+		return nil
+	}
+
+	data, ok := value.([]byte)
+	if !ok {
+		str, err := validate.EnsureString(value)
+		if err != nil {
+			return err
+		}
+		if data, err = base64.StdEncoding.DecodeString(str); err != nil {
+			return r.error()
+		}
+	}
+
+	if err := proto.Unmarshal(data, dynamicpb.NewMessage(r.descriptor)); err != nil {
+		return r.error()
+	}
+	return nil
+}
+
+func (r MessageRule) error() validate.Error {
+	return r.err.SetParams(map[string]interface{}{"message": r.descriptor.Name()})
+}
+
+// Error sets the error message for the rule.
+func (r MessageRule) Error(message string) MessageRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r MessageRule) ErrorObject(err validate.Error) MessageRule {
+	r.err = err
+	return r
+}
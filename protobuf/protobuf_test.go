@@ -0,0 +1,64 @@
+package protobuf
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/nanoteck137/validate"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestMessage(t *testing.T) {
+	valid, err := proto.Marshal(&durationpb.Duration{Seconds: 90, Nanos: 5})
+	if !assert.Nil(t, err) {
+		return
+	}
+	encoded := base64.StdEncoding.EncodeToString(valid)
+	truncated := []byte{0x08}
+
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", "", ""},
+		{"t2", encoded, ""},
+		{"t3", &encoded, ""},
+		{"t4", valid, ""},
+		{"t5", truncated, "must be a valid Duration"},
+		{"t6", base64.StdEncoding.EncodeToString(truncated), "must be a valid Duration"},
+		{"t7", "not base64!", "must be a valid Duration"},
+		{"t8", 123, "must be either a string or byte slice"},
+	}
+
+	descriptor := (&durationpb.Duration{}).ProtoReflect().Descriptor()
+	for _, test := range tests {
+		err := Message(descriptor).Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestMessageRule_Error(t *testing.T) {
+	r := Message((&durationpb.Duration{}).ProtoReflect().Descriptor()).Error("bad {{.message}} payload")
+	assert.Equal(t, "bad Duration payload", r.Validate([]byte{0x08}).Error())
+}
+
+func TestMessageRule_ErrorObject(t *testing.T) {
+	r := Message(nil)
+	err := validate.NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
+
+func assertError(t *testing.T, expected string, err error, tag string) {
+	if expected == "" {
+		assert.NoError(t, err, tag)
+	} else {
+		assert.EqualError(t, err, expected, tag)
+	}
+}
@@ -0,0 +1,93 @@
+package validate
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+)
+
+var (
+	// ErrRange is the error that returns when a value is not within an inclusive range.
+	ErrRange = NewError("validation_range", "must be between {{.min}} and {{.max}}")
+	// ErrRangeExclusive is the error that returns when a value is not within an exclusive range.
+	ErrRangeExclusive = NewError("validation_range_exclusive", "must be strictly between {{.min}} and {{.max}}")
+)
+
+// Range returns a validation rule that checks if a value is within the inclusive range [min, max].
+// It is a shortcut for Min(min) and Max(max) that produces a single error message. By calling Exclusive,
+// the rule will check if the value is strictly between min and max.
+// Note that the value being checked and the bounds must be of the same type.
+// Only int, uint, float, time.Duration and time.Time types are supported; other types and
+// a min greater than max cause an internal error.
+// An empty value is considered valid. Please use the Required rule to make sure a value is not empty.
+func Range(min, max interface{}) RangeRule {
+	return RangeRule{
+		min: Min(min),
+		max: Max(max),
+		err: ErrRange,
+	}
+}
+
+// RangeRule is a validation rule that checks if a value is within a range.
+type RangeRule struct {
+	min, max ThresholdRule
+	err      Error
+}
+
+// Exclusive sets the comparison to exclude the boundary values.
+func (r RangeRule) Exclusive() RangeRule {
+	r.min = r.min.Exclusive()
+	r.max = r.max.Exclusive()
+	if r.err.Code() == ErrRange.Code() && r.err.Message() == ErrRange.Message() {
+		r.err = ErrRangeExclusive
+	}
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r RangeRule) Validate(value interface{}) error {
+	min, max := r.min.threshold, r.max.threshold
+	if reflect.TypeOf(min) != reflect.TypeOf(max) {
+		return NewInternalError(fmt.Errorf("range bounds must be of the same type: %T and %T", min, max))
+	}
+	switch reflect.ValueOf(min).Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+	default:
+		if _, ok := min.(time.Time); !ok {
+			return NewInternalError(fmt.Errorf("type not supported: %T", min))
+		}
+	}
+	// either bound may be a zero value, which the threshold rules consider empty
+	_, minTooLarge := Max(max).Validate(min).(Error)
+	_, maxTooSmall := Min(min).Validate(max).(Error)
+	if minTooLarge || maxTooSmall {
+		return NewInternalError(fmt.Errorf("range min %v is greater than max %v", min, max))
+	}
+
+	for _, rule := range []ThresholdRule{r.min, r.max} {
+		if err := rule.Validate(value); err != nil {
+			if _, ok := err.(Error); !ok {
+				return err
+			}
+			return r.err.SetParams(map[string]interface{}{
+				"min": formatThreshold(min),
+				"max": formatThreshold(max),
+			})
+		}
+	}
+	return nil
+}
+
+// Error sets the error message for the rule.
+func (r RangeRule) Error(message string) RangeRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r RangeRule) ErrorObject(err Error) RangeRule {
+	r.err = err
+	return r
+}
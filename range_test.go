@@ -0,0 +1,109 @@
+package validate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRange(t *testing.T) {
+	date0 := time.Time{}
+	date20000101 := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	date20001201 := time.Date(2000, 12, 1, 0, 0, 0, 0, time.UTC)
+	date20010101 := time.Date(2001, 1, 1, 0, 0, 0, 0, time.UTC)
+	var nilPtr *int
+	fifty := 50
+
+	tests := []struct {
+		tag   string
+		min   interface{}
+		max   interface{}
+		value interface{}
+		err   string
+	}{
+		// int cases
+		{"t1.1", 1, 100, 1, ""},
+		{"t1.2", 1, 100, 100, ""},
+		{"t1.3", 1, 100, 0, ""},
+		{"t1.4", 1, 100, -1, "must be between 1 and 100"},
+		{"t1.5", 1, 100, 101, "must be between 1 and 100"},
+		{"t1.6", 1, 100, &fifty, ""},
+		{"t1.7", 1, 100, nilPtr, ""},
+		{"t1.8", 1, 100, "50", "cannot convert string to int64"},
+		// uint cases
+		{"t2.1", uint(10), uint(20), uint(10), ""},
+		{"t2.2", uint(10), uint(20), uint(21), "must be between 10 and 20"},
+		// float cases
+		{"t3.2", 0.1, 0.3, 0.3, ""},
+		{"t3.3", 0.1, 0.3, 0.30000000000000004, "must be between 0.1 and 0.3"},
+		{"t3.4", -1.5, 1.5, float32(1.5), ""},
+		// time.Duration cases
+		{"t4.1", time.Second, time.Minute, 30 * time.Second, ""},
+		{"t4.2", time.Second, time.Minute, 2 * time.Minute, "must be between 1s and 1m0s"},
+		// time.Time cases
+		{"t5.1", date20000101, date20010101, date20001201, ""},
+		{"t5.2", date20000101, date20001201, date20010101, "must be between 2000-01-01 00:00:00 +0000 UTC and 2000-12-01 00:00:00 +0000 UTC"},
+		{"t5.3", date20000101, date20010101, date0, ""},
+	}
+
+	for _, test := range tests {
+		err := Range(test.min, test.max).Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestRangeRule_Exclusive(t *testing.T) {
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", 1, "must be strictly between 1 and 100"},
+		{"t2", 2, ""},
+		{"t3", 99, ""},
+		{"t4", 100, "must be strictly between 1 and 100"},
+	}
+
+	for _, test := range tests {
+		err := Range(1, 100).Exclusive().Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	r := Range(1, 100).Error("out of range").Exclusive()
+	assert.EqualError(t, r.Validate(1), "out of range")
+}
+
+func TestRange_InternalError(t *testing.T) {
+	rules := []RangeRule{
+		Range(100, 1),
+		Range(0, -1),
+		Range(5, 0),
+		Range(1, 2.5),
+		Range("a", "b"),
+		Range(struct{}{}, struct{}{}),
+	}
+	for i, r := range rules {
+		err := r.Validate(1)
+		if assert.NotNil(t, err, i) {
+			_, ok := err.(InternalError)
+			assert.True(t, ok, i)
+		}
+	}
+	assert.EqualError(t, Range(100, 1).Validate(50), "range min 100 is greater than max 1")
+}
+
+func TestRangeRule_Error(t *testing.T) {
+	r := Range(1, 10).Error("pick a number from {{.min}} to {{.max}}")
+	assert.Equal(t, "pick a number from 1 to 10", r.Validate(11).Error())
+}
+
+func TestRangeRule_ErrorObject(t *testing.T) {
+	r := Range(1, 10)
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
@@ -0,0 +1,92 @@
+package validate
+
+import "strings"
+
+var (
+	// ErrInRanges is the error that returns when an integer is not within any of the allowed ranges.
+	ErrInRanges = NewError("validation_in_ranges", "must be within an allowed range")
+	// ErrInLabeledRanges is the error that returns when an integer is not within any of the allowed labeled ranges.
+	ErrInLabeledRanges = NewError("validation_in_labeled_ranges", "must be within one of: {{.labels}}")
+)
+
+// LabeledRange is an inclusive integer range with a label that is used in error messages.
+type LabeledRange struct {
+	Label    string
+	Min, Max int
+}
+
+// InRanges returns a validation rule that checks if an integer is within any of the given inclusive ranges,
+// each specified as [min, max]. For example, to allow 2xx and 4xx HTTP status codes:
+//    validation.InRanges([2]int{200, 299}, [2]int{400, 499})
+//
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func InRanges(ranges ...[2]int) InRangesRule {
+	labeled := make([]LabeledRange, len(ranges))
+	for i, rg := range ranges {
+		labeled[i] = LabeledRange{Min: rg[0], Max: rg[1]}
+	}
+	return InRangesRule{
+		ranges: labeled,
+		err:    ErrInRanges,
+	}
+}
+
+// InLabeledRanges returns a validation rule that checks if an integer is within any of the given inclusive
+// ranges. The labels of the ranges are listed in the error message, e.g.
+//    validation.InLabeledRanges(
+//        validation.LabeledRange{Label: "2xx", Min: 200, Max: 299},
+//        validation.LabeledRange{Label: "4xx", Min: 400, Max: 499},
+//    )
+//
+// fails with "must be within one of: 2xx, 4xx".
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func InLabeledRanges(ranges ...LabeledRange) InRangesRule {
+	labels := make([]string, len(ranges))
+	for i, rg := range ranges {
+		labels[i] = rg.Label
+	}
+	return InRangesRule{
+		ranges: ranges,
+		err:    ErrInLabeledRanges.SetParams(map[string]interface{}{"labels": strings.Join(labels, ", ")}),
+	}
+}
+
+// InRangesRule is a validation rule that checks if an integer is within any of a set of ranges.
+type InRangesRule struct {
+	ranges []LabeledRange
+	err    Error
+}
+
+// Validate checks if the given value is valid or not.
+func (r InRangesRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	n, err := toInt64(value)
+	if err == errIntOverflow {
+		return r.err
+	} else if err != nil {
+		return err
+	}
+
+	for _, rg := range r.ranges {
+		if n >= int64(rg.Min) && n <= int64(rg.Max) {
+			return nil
+		}
+	}
+	return r.err
+}
+
+// Error sets the error message for the rule.
+func (r InRangesRule) Error(message string) InRangesRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r InRangesRule) ErrorObject(err Error) InRangesRule {
+	r.err = err
+	return r
+}
@@ -0,0 +1,63 @@
+package validate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInRanges(t *testing.T) {
+	var nilPtr *int
+	code := 201
+
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", 0, ""},
+		{"t2", nilPtr, ""},
+		{"t3", 200, ""},
+		{"t4", 299, ""},
+		{"t5", 404, ""},
+		{"t6", 300, "must be within an allowed range"},
+		{"t7", 500, "must be within an allowed range"},
+		{"t8", &code, ""},
+		{"t9", uint16(204), ""},
+		{"t10", uint64(1 << 63), "must be within an allowed range"},
+		{"t11", "200", "cannot convert string to int64"},
+	}
+
+	for _, test := range tests {
+		err := InRanges([2]int{200, 299}, [2]int{400, 499}).Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestInLabeledRanges(t *testing.T) {
+	r := InLabeledRanges(
+		LabeledRange{Label: "2xx", Min: 200, Max: 299},
+		LabeledRange{Label: "4xx", Min: 400, Max: 499},
+	)
+	assert.Nil(t, r.Validate(204))
+	assert.Nil(t, r.Validate(418))
+	assert.EqualError(t, r.Validate(302), "must be within one of: 2xx, 4xx")
+}
+
+func TestInRangesRule_Error(t *testing.T) {
+	r := InLabeledRanges(LabeledRange{Label: "2xx", Min: 200, Max: 299}).Error("expected {{.labels}}")
+	assert.Equal(t, "expected 2xx", r.Validate(500).Error())
+
+	r = InRanges().Error("123")
+	assert.Equal(t, "123", r.err.Message())
+}
+
+func TestInRangesRule_ErrorObject(t *testing.T) {
+	r := InRanges()
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
@@ -0,0 +1,69 @@
+package validate
+
+// ErrRatioOutOfRange is the error that returns when the ratio between two values is out of range.
+var ErrRatioOutOfRange = NewError("validation_ratio_out_of_range", "ratio must be between {{.min}} and {{.max}}")
+
+// RatioBetween returns a validation rule that checks if the ratio between the value being validated
+// and the value referenced by otherPtr is within the inclusive range [min, max].
+// otherPtr should be a pointer to a sibling field, which makes the rule usable within ValidateStruct.
+// For example,
+//    validation.Field(&s.Width, validation.RatioBetween(&s.Height, 0.5, 2))
+//
+// Both values must be of int, uint or float types. If the referenced value is zero,
+// the ratio is undefined and the rule fails.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func RatioBetween(otherPtr interface{}, min, max float64) RatioRule {
+	return RatioRule{
+		other: otherPtr,
+		min:   min,
+		max:   max,
+		err:   ErrRatioOutOfRange,
+	}
+}
+
+// RatioRule is a validation rule that checks if the ratio between two values is within a range.
+type RatioRule struct {
+	other    interface{}
+	min, max float64
+	err      Error
+}
+
+// Validate checks if the given value is valid or not.
+func (r RatioRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	v, err := ToNumber(value)
+	if err != nil {
+		return err
+	}
+
+	var o float64
+	if other, isNil := Indirect(r.other); !isNil {
+		if o, err = ToNumber(other); err != nil {
+			return err
+		}
+	}
+
+	if o != 0 {
+		if ratio := v / o; ratio >= r.min && ratio <= r.max {
+			return nil
+		}
+	}
+
+	return r.err.SetParams(map[string]interface{}{"min": r.min, "max": r.max})
+}
+
+// Error sets the error message for the rule.
+func (r RatioRule) Error(message string) RatioRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r RatioRule) ErrorObject(err Error) RatioRule {
+	r.err = err
+	return r
+}
@@ -0,0 +1,71 @@
+package validate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRatioBetween(t *testing.T) {
+	var nilPtr *int
+	height := 100
+	zero := 0
+	floatHeight := 50.0
+	str := "abc"
+
+	tests := []struct {
+		tag   string
+		other interface{}
+		value interface{}
+		err   string
+	}{
+		{"t1", &height, 0, ""},
+		{"t2", &height, 100, ""},
+		{"t3", &height, 50, ""},
+		{"t4", &height, 200, ""},
+		{"t5", &height, 49, "ratio must be between 0.5 and 2"},
+		{"t6", &height, 201, "ratio must be between 0.5 and 2"},
+		{"t7", &zero, 10, "ratio must be between 0.5 and 2"},
+		{"t8", nilPtr, 10, "ratio must be between 0.5 and 2"},
+		{"t9", &floatHeight, uint(60), ""},
+		{"t10", &height, "abc", "cannot convert string to a number"},
+		{"t11", &str, 10, "cannot convert string to a number"},
+	}
+
+	for _, test := range tests {
+		r := RatioBetween(test.other, 0.5, 2)
+		err := r.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestRatioBetween_ValidateStruct(t *testing.T) {
+	s := struct {
+		Width  int
+		Height int
+	}{Width: 300, Height: 100}
+
+	err := ValidateStruct(&s, Field(&s.Width, RatioBetween(&s.Height, 0.5, 2)))
+	assert.EqualError(t, err, "Width: ratio must be between 0.5 and 2.")
+
+	s.Width = 150
+	assert.Nil(t, ValidateStruct(&s, Field(&s.Width, RatioBetween(&s.Height, 0.5, 2))))
+}
+
+func TestRatioRule_Error(t *testing.T) {
+	r := RatioBetween(nil, 0.5, 2)
+	assert.Equal(t, "ratio must be between 0.5 and 2", r.Validate(10).Error())
+
+	r = r.Error("aspect ratio must be between {{.min}} and {{.max}}")
+	assert.Equal(t, "aspect ratio must be between 0.5 and 2", r.Validate(10).Error())
+}
+
+func TestRatioRule_ErrorObject(t *testing.T) {
+	r := RatioBetween(nil, 0.5, 2)
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
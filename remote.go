@@ -0,0 +1,96 @@
+package validate
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// ErrRemoteInvalid is the error that returns when a remote validation endpoint rejects a value without a message.
+var ErrRemoteInvalid = NewError("validation_remote_invalid", "is invalid")
+
+// maxRemoteResponseSize limits how much of a rejection response is read as the error message.
+const maxRemoteResponseSize = 64 << 10
+
+// Remote returns a context-aware validation rule that delegates validation to an HTTP endpoint.
+// The value is encoded as JSON and POSTed to the endpoint. A 2xx response means the value is valid.
+// Any other status means the value is invalid, and the response body is used as the error message.
+// If the body is empty, ErrRemoteInvalid is returned instead.
+//
+// The request honors the deadline and cancellation of the context passed to ValidateWithContext.
+// When the rule is used through Validate, context.Background() is used.
+// Failures to reach the endpoint are returned as internal errors.
+// If client is nil, http.DefaultClient is used.
+func Remote(endpoint string, client *http.Client) RemoteRule {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return RemoteRule{
+		endpoint: endpoint,
+		client:   client,
+		err:      ErrRemoteInvalid,
+	}
+}
+
+// RemoteRule is a validation rule that delegates validation to an HTTP endpoint.
+type RemoteRule struct {
+	endpoint string
+	client   *http.Client
+	err      Error
+}
+
+// Validate checks if the given value is valid or not.
+func (r RemoteRule) Validate(value interface{}) error {
+	return r.ValidateWithContext(context.Background(), value)
+}
+
+// ValidateWithContext checks if the given value is valid or not using the given context.
+func (r RemoteRule) ValidateWithContext(ctx context.Context, value interface{}) error {
+	body, err := json.Marshal(value)
+	if err != nil {
+		return NewInternalError(err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return NewInternalError(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := r.client.Do(req)
+	if err != nil {
+		return NewInternalError(err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= 200 && res.StatusCode < 300 {
+		return nil
+	}
+
+	msg, err := ioutil.ReadAll(io.LimitReader(res.Body, maxRemoteResponseSize))
+	if err != nil {
+		return NewInternalError(err)
+	}
+	if m := strings.TrimSpace(string(msg)); m != "" {
+		// the body is used verbatim, so drop any params to keep it from being parsed as a template
+		return r.err.SetMessage(m).SetParams(nil)
+	}
+	return r.err
+}
+
+// Error sets the error message that is used when the endpoint rejects a value without a message.
+func (r RemoteRule) Error(message string) RemoteRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+// Its message is replaced with the response body when the endpoint provides one.
+func (r RemoteRule) ErrorObject(err Error) RemoteRule {
+	r.err = err
+	return r
+}
@@ -0,0 +1,52 @@
+package validate
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRemote(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := ioutil.ReadAll(req.Body)
+		switch string(body) {
+		case `"ok"`:
+			w.WriteHeader(http.StatusNoContent)
+		case `"empty"`:
+			w.WriteHeader(http.StatusUnprocessableEntity)
+		case `"slow"`:
+			time.Sleep(200 * time.Millisecond)
+		default:
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			_, _ = w.Write([]byte("value is reserved {{.x}}\n"))
+		}
+	}))
+	defer srv.Close()
+
+	r := Remote(srv.URL, srv.Client())
+	assert.Nil(t, r.Validate("ok"))
+	assert.EqualError(t, r.Validate("bad"), "value is reserved {{.x}}")
+	assert.EqualError(t, r.Validate("empty"), "is invalid")
+	assert.EqualError(t, r.Error("rejected").Validate("empty"), "rejected")
+	assert.Nil(t, ValidateWithContext(context.Background(), "ok", r))
+
+	err := r.ErrorObject(NewError("code", "abc").SetParams(map[string]interface{}{"x": 1})).Validate("bad")
+	assert.EqualError(t, err, "value is reserved {{.x}}")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	err = ValidateWithContext(ctx, "slow", r)
+	if assert.Implements(t, (*InternalError)(nil), err) {
+		assert.Contains(t, err.Error(), "context deadline exceeded")
+	}
+
+	_, ok := Remote("http://127.0.0.1:0", nil).Validate("ok").(InternalError)
+	assert.True(t, ok)
+	_, ok = r.Validate(make(chan int)).(InternalError)
+	assert.True(t, ok)
+}
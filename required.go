@@ -18,6 +18,7 @@ var (
 // - string, array, slice, map: len() > 0
 // - interface, pointer: not nil and the referenced value is not empty
 // - any other types
+// Values implementing Emptier are checked by their IsEmpty method instead. See IsEmpty for details.
 var Required = RequiredRule{skipNil: false, condition: true}
 
 // NilOrNotEmpty checks if a value is a nil pointer or a value that is not empty.
@@ -34,8 +35,8 @@ type RequiredRule struct {
 // Validate checks if the given value is valid or not.
 func (r RequiredRule) Validate(value interface{}) error {
 	if r.condition {
-		value, isNil := Indirect(value)
-		if r.skipNil && !isNil && IsEmpty(value) || !r.skipNil && (isNil || IsEmpty(value)) {
+		isNil, empty := checkEmpty(value)
+		if r.skipNil && !isNil && empty || !r.skipNil && (isNil || empty) {
 			if r.err != nil {
 				return r.err
 			}
@@ -0,0 +1,103 @@
+package validate
+
+// ErrRequiredWith is the error that returns when a value is required because of other fields.
+var ErrRequiredWith = NewError("validation_required_with", "cannot be blank")
+
+// RequiredWith returns a validation rule that checks if a value is not empty when any of the fields
+// referenced by fieldPtrs is not empty. fieldPtrs should point to sibling fields, which makes the rule
+// usable within ValidateStruct:
+//    validation.Field(&f.ConfirmPassword, validation.RequiredWith(&f.Password))
+//
+// The fields are resolved when the rule is evaluated. If all of them are nil or empty, the value is optional
+// and the rule passes; the rules following it are still evaluated, and most of them treat an empty value as valid.
+// Emptiness is determined the same way as for the Required rule.
+func RequiredWith(fieldPtrs ...interface{}) RequiredWithRule {
+	return RequiredWithRule{
+		fields: fieldPtrs,
+		err:    ErrRequiredWith,
+	}
+}
+
+// RequiredWithAll returns a validation rule that checks if a value is not empty when all of the fields
+// referenced by fieldPtrs are not empty. It is otherwise similar to RequiredWith.
+func RequiredWithAll(fieldPtrs ...interface{}) RequiredWithRule {
+	r := RequiredWith(fieldPtrs...)
+	r.mode = requiredWithAll
+	return r
+}
+
+// RequiredWithout returns a validation rule that checks if a value is not empty when any of the fields
+// referenced by fieldPtrs is nil or empty. It is otherwise similar to RequiredWith.
+func RequiredWithout(fieldPtrs ...interface{}) RequiredWithRule {
+	r := RequiredWith(fieldPtrs...)
+	r.mode = requiredWithout
+	return r
+}
+
+// RequiredWithoutAll returns a validation rule that checks if a value is not empty when all of the fields
+// referenced by fieldPtrs are nil or empty. It is otherwise similar to RequiredWith.
+func RequiredWithoutAll(fieldPtrs ...interface{}) RequiredWithRule {
+	r := RequiredWith(fieldPtrs...)
+	r.mode = requiredWithoutAll
+	return r
+}
+
+type requiredWithMode int
+
+const (
+	requiredWithAny requiredWithMode = iota
+	requiredWithAll
+	requiredWithout
+	requiredWithoutAll
+)
+
+// RequiredWithRule is a validation rule that checks if a value is not empty depending on other fields.
+// A rule without any referenced fields never requires the value.
+type RequiredWithRule struct {
+	fields []interface{}
+	mode   requiredWithMode
+	err    Error
+}
+
+// Validate checks if the given value is valid or not.
+func (r RequiredWithRule) Validate(value interface{}) error {
+	if !isNilOrEmpty(value) || !r.required() {
+		return nil
+	}
+	return r.err
+}
+
+// required checks if the referenced fields make the value required.
+func (r RequiredWithRule) required() bool {
+	if len(r.fields) == 0 {
+		return false
+	}
+	present := 0
+	for _, field := range r.fields {
+		if !isNilOrEmpty(field) {
+			present++
+		}
+	}
+	switch r.mode {
+	case requiredWithAll:
+		return present == len(r.fields)
+	case requiredWithout:
+		return present < len(r.fields)
+	case requiredWithoutAll:
+		return present == 0
+	default:
+		return present > 0
+	}
+}
+
+// Error sets the error message for the rule.
+func (r RequiredWithRule) Error(message string) RequiredWithRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r RequiredWithRule) ErrorObject(err Error) RequiredWithRule {
+	r.err = err
+	return r
+}
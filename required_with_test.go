@@ -0,0 +1,164 @@
+package validate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequiredWith(t *testing.T) {
+	var nilPtr *string
+	s := "abc"
+	empty := ""
+
+	tests := []struct {
+		tag    string
+		fields []interface{}
+		value  interface{}
+		err    string
+	}{
+		{"t1", []interface{}{&empty}, "", ""},
+		{"t2", []interface{}{&empty}, nilPtr, ""},
+		{"t3", []interface{}{&s}, "", "cannot be blank"},
+		{"t4", []interface{}{&s}, nilPtr, "cannot be blank"},
+		{"t5", []interface{}{&s}, "x", ""},
+		{"t6", []interface{}{&empty, &s}, "", "cannot be blank"},
+		{"t7", []interface{}{&nilPtr, &empty}, "", ""},
+		{"t8", []interface{}{}, "", ""},
+	}
+
+	for _, test := range tests {
+		err := RequiredWith(test.fields...).Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestRequiredWith_Modes(t *testing.T) {
+	s := "abc"
+	empty := ""
+	var nilPtr *string
+	rules := map[string]func(...interface{}) RequiredWithRule{
+		"RequiredWith":       RequiredWith,
+		"RequiredWithAll":    RequiredWithAll,
+		"RequiredWithout":    RequiredWithout,
+		"RequiredWithoutAll": RequiredWithoutAll,
+	}
+
+	// required lists, per rule, whether an empty value is rejected for the given sibling fields.
+	tests := []struct {
+		tag      string
+		fields   []interface{}
+		required map[string]bool
+	}{
+		{"none", []interface{}{}, map[string]bool{}},
+		{"present", []interface{}{&s}, map[string]bool{"RequiredWith": true, "RequiredWithAll": true}},
+		{"empty", []interface{}{&empty}, map[string]bool{"RequiredWithout": true, "RequiredWithoutAll": true}},
+		{"nil", []interface{}{&nilPtr}, map[string]bool{"RequiredWithout": true, "RequiredWithoutAll": true}},
+		{"present,present", []interface{}{&s, &s}, map[string]bool{"RequiredWith": true, "RequiredWithAll": true}},
+		{"present,empty", []interface{}{&s, &empty}, map[string]bool{"RequiredWith": true, "RequiredWithout": true}},
+		{"empty,present", []interface{}{&nilPtr, &s}, map[string]bool{"RequiredWith": true, "RequiredWithout": true}},
+		{"empty,empty", []interface{}{&empty, &nilPtr}, map[string]bool{"RequiredWithout": true, "RequiredWithoutAll": true}},
+	}
+
+	for _, test := range tests {
+		for name, rule := range rules {
+			tag := name + "/" + test.tag
+			err := rule(test.fields...).Validate("")
+			if test.required[name] {
+				assert.Equal(t, ErrRequiredWith, err, tag)
+			} else {
+				assert.Nil(t, err, tag)
+			}
+			// a non-empty value always passes
+			assert.Nil(t, rule(test.fields...).Validate("x"), tag)
+		}
+	}
+}
+
+func TestRequiredWithout_ValidateStruct(t *testing.T) {
+	type contact struct {
+		Email string
+		Phone string
+		Fax   string
+	}
+	validate := func(c *contact) error {
+		return ValidateStruct(c,
+			Field(&c.Email, RequiredWithoutAll(&c.Phone, &c.Fax)),
+			Field(&c.Fax, RequiredWithAll(&c.Email, &c.Phone)),
+			Field(&c.Phone, RequiredWithout(&c.Email)),
+		)
+	}
+
+	tests := []struct {
+		tag     string
+		contact contact
+		err     string
+	}{
+		{"t1", contact{}, "Email: cannot be blank; Phone: cannot be blank."},
+		{"t2", contact{Phone: "1"}, ""},
+		{"t3", contact{Email: "a@example.com"}, ""},
+		{"t4", contact{Email: "a@example.com", Phone: "1"}, "Fax: cannot be blank."},
+		{"t5", contact{Email: "a@example.com", Phone: "1", Fax: "2"}, ""},
+		{"t6", contact{Fax: "2"}, "Phone: cannot be blank."},
+	}
+
+	for _, test := range tests {
+		err := validate(&test.contact)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestRequiredWith_ValidateStruct(t *testing.T) {
+	type form struct {
+		Password        string
+		ConfirmPassword string
+		Email           string
+		Phone           *string
+		Contact         string
+	}
+	validate := func(f *form) error {
+		return ValidateStruct(f,
+			Field(&f.ConfirmPassword, RequiredWith(&f.Password), Length(8, 0)),
+			Field(&f.Contact, RequiredWith(&f.Email, &f.Phone)),
+		)
+	}
+	phone := "123"
+
+	tests := []struct {
+		tag  string
+		form form
+		err  string
+	}{
+		{"t1", form{}, ""},
+		{"t2", form{Password: "secret12"}, "ConfirmPassword: cannot be blank."},
+		{"t3", form{Password: "secret12", ConfirmPassword: "short"}, "ConfirmPassword: the length must be no less than 8."},
+		{"t4", form{Password: "secret12", ConfirmPassword: "secret12"}, ""},
+		{"t5", form{ConfirmPassword: "short"}, "ConfirmPassword: the length must be no less than 8."},
+		{"t6", form{Email: "a@example.com"}, "Contact: cannot be blank."},
+		{"t7", form{Phone: &phone}, "Contact: cannot be blank."},
+		{"t8", form{Password: "x", Phone: &phone}, "ConfirmPassword: cannot be blank; Contact: cannot be blank."},
+		{"t9", form{Email: "a@example.com", Contact: "Ann"}, ""},
+	}
+
+	for _, test := range tests {
+		err := validate(&test.form)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestRequiredWithRule_Error(t *testing.T) {
+	s := "abc"
+	r := RequiredWith(&s).Error("must be confirmed")
+	assert.Equal(t, "must be confirmed", r.Validate("").Error())
+	assert.Equal(t, ErrRequiredWith.Code(), r.err.Code())
+}
+
+func TestRequiredWithRule_ErrorObject(t *testing.T) {
+	r := RequiredWith()
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
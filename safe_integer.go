@@ -0,0 +1,90 @@
+package validate
+
+import (
+	"math"
+	"reflect"
+)
+
+// maxSafeInteger is the largest integer n such that n and n+1 are both exactly representable as a float64.
+const maxSafeInteger = 1<<53 - 1
+
+var (
+	// ErrSafeIntegerRange is the error that returns when a number is outside the safe integer range.
+	ErrSafeIntegerRange = NewError("validation_safe_integer_range", "number is too large to be represented exactly")
+	// ErrSafeIntegerFraction is the error that returns when a number has a fractional part.
+	ErrSafeIntegerFraction = NewError("validation_safe_integer_fraction", "must be an integer")
+)
+
+// SafeInteger returns a validation rule that checks if a number is an integer within the range
+// [-(2^53-1), 2^53-1] which IEEE-754 double precision floats (and thus JSON numbers in JavaScript)
+// represent exactly. This guards identifiers that are transmitted as JSON numbers against silent corruption.
+// Float values must not have a fractional part. int and uint values are only checked against the range.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func SafeInteger() SafeIntegerRule {
+	return SafeIntegerRule{
+		err:         ErrSafeIntegerRange,
+		fractionErr: ErrSafeIntegerFraction,
+	}
+}
+
+// SafeIntegerRule is a validation rule that checks if a number is exactly representable as an IEEE-754 integer.
+type SafeIntegerRule struct {
+	err, fractionErr Error
+}
+
+// Validate checks if the given value is valid or not.
+func (r SafeIntegerRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	switch reflect.ValueOf(value).Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if v, _ := ToInt(value); v > maxSafeInteger || v < -maxSafeInteger {
+			return r.err
+		}
+		return nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if v, _ := ToUint(value); v > maxSafeInteger {
+			return r.err
+		}
+		return nil
+	}
+
+	v, err := ToFloat(value)
+	if err != nil {
+		return err
+	}
+	if math.IsNaN(v) || math.IsInf(v, 0) || math.Abs(v) > maxSafeInteger {
+		return r.err
+	}
+	if v != math.Trunc(v) {
+		return r.fractionErr
+	}
+	return nil
+}
+
+// Error sets the error message that is used when the number is outside the safe integer range.
+func (r SafeIntegerRule) Error(message string) SafeIntegerRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct that is used when the number is outside the safe integer range.
+func (r SafeIntegerRule) ErrorObject(err Error) SafeIntegerRule {
+	r.err = err
+	return r
+}
+
+// FractionError sets the error message that is used when the number has a fractional part.
+func (r SafeIntegerRule) FractionError(message string) SafeIntegerRule {
+	r.fractionErr = r.fractionErr.SetMessage(message)
+	return r
+}
+
+// FractionErrorObject sets the error struct that is used when the number has a fractional part.
+func (r SafeIntegerRule) FractionErrorObject(err Error) SafeIntegerRule {
+	r.fractionErr = err
+	return r
+}
@@ -0,0 +1,55 @@
+package validate
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSafeInteger(t *testing.T) {
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", 0.0, ""},
+		{"t2", 42.0, ""},
+		{"t3", -42.0, ""},
+		{"t4", float64(1<<53 - 1), ""},
+		{"t5", float64(1 << 53), "number is too large to be represented exactly"},
+		{"t6", -float64(1 << 53), "number is too large to be represented exactly"},
+		{"t7", 1.5, "must be an integer"},
+		{"t8", float32(2), ""},
+		{"t9", math.NaN(), "number is too large to be represented exactly"},
+		{"t10", math.Inf(1), "number is too large to be represented exactly"},
+		{"t11", int64(1<<53 - 1), ""},
+		{"t12", int64(1 << 53), "number is too large to be represented exactly"},
+		{"t13", int64(-1 << 53), "number is too large to be represented exactly"},
+		{"t14", uint64(1 << 60), "number is too large to be represented exactly"},
+		{"t15", uint8(1), ""},
+		{"t16", "1", "cannot convert string to float64"},
+	}
+
+	for _, test := range tests {
+		err := SafeInteger().Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestSafeIntegerRule_Error(t *testing.T) {
+	r := SafeInteger().Error("too big").FractionError("no fractions")
+	assert.Equal(t, "too big", r.Validate(1e20).Error())
+	assert.Equal(t, "no fractions", r.Validate(0.5).Error())
+}
+
+func TestSafeIntegerRule_ErrorObject(t *testing.T) {
+	r := SafeInteger()
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err).FractionErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err, r.fractionErr)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
@@ -0,0 +1,77 @@
+package validate
+
+import (
+	"regexp"
+	"strings"
+)
+
+// ErrSequencePattern is the error that returns when a sequence of elements does not match a pattern.
+var ErrSequencePattern = NewError("validation_sequence_pattern", "sequence is invalid")
+
+// SequencePattern returns a validation rule that checks if the elements of a slice or array, taken in order,
+// match a regular expression. The classify function maps each element to a symbol, and the symbols are
+// concatenated into a string that must fully match the pattern. Single-character symbols keep the patterns
+// simple. For example, to require a log of events to start with an "open", end with a "close" and contain
+// only "update" events in between:
+//    validation.SequencePattern(func(v interface{}) string {
+//        return v.(Event).Kind[:1] // "o", "u" or "c"
+//    }, "ou*c")
+//
+// The pattern is implicitly anchored at both ends. If it cannot be compiled, the rule returns an internal error.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func SequencePattern(classify func(interface{}) string, pattern string) SequencePatternRule {
+	re, err := regexp.Compile("^(?:" + pattern + ")$")
+	return SequencePatternRule{
+		classify:   classify,
+		re:         re,
+		patternErr: err,
+		err:        ErrSequencePattern,
+	}
+}
+
+// SequencePatternRule is a validation rule that checks if the sequence of elements of a slice matches a pattern.
+type SequencePatternRule struct {
+	classify   func(interface{}) string
+	re         *regexp.Regexp
+	patternErr error
+	err        Error
+}
+
+// Validate checks if the given value is valid or not.
+func (r SequencePatternRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	v, err := sliceValue(value)
+	if err != nil {
+		return err
+	}
+
+	if r.patternErr != nil {
+		return NewInternalError(r.patternErr)
+	}
+
+	var symbols strings.Builder
+	for i := 0; i < v.Len(); i++ {
+		symbols.WriteString(r.classify(v.Index(i).Interface()))
+	}
+
+	if r.re.MatchString(symbols.String()) {
+		return nil
+	}
+	return r.err
+}
+
+// Error sets the error message for the rule.
+func (r SequencePatternRule) Error(message string) SequencePatternRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r SequencePatternRule) ErrorObject(err Error) SequencePatternRule {
+	r.err = err
+	return r
+}
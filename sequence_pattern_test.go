@@ -0,0 +1,61 @@
+package validate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSequencePattern(t *testing.T) {
+	type event struct {
+		Kind string
+	}
+	classify := func(v interface{}) string {
+		return v.(event).Kind[:1]
+	}
+	open, update, closing := event{"open"}, event{"update"}, event{"close"}
+
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", []event{}, ""},
+		{"t2", []event{open, closing}, ""},
+		{"t3", []event{open, update, update, closing}, ""},
+		{"t4", []event{update, closing}, "sequence is invalid"},
+		{"t5", []event{open, update}, "sequence is invalid"},
+		{"t6", []event{open, closing, closing}, "sequence is invalid"},
+		{"t7", [2]event{open, closing}, ""},
+		{"t8", "oc", "must be a slice or an array"},
+	}
+
+	for _, test := range tests {
+		r := SequencePattern(classify, "ou*c")
+		err := r.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	err := SequencePattern(classify, "(").Validate([]event{open})
+	if assert.Implements(t, (*InternalError)(nil), err) {
+		assert.Contains(t, err.Error(), "missing closing )")
+	}
+}
+
+func TestSequencePatternRule_Error(t *testing.T) {
+	r := SequencePattern(func(interface{}) string { return "x" }, "y")
+	assert.Equal(t, "sequence is invalid", r.Validate([]int{1}).Error())
+
+	r = r.Error("event sequence is invalid")
+	assert.Equal(t, "event sequence is invalid", r.Validate([]int{1}).Error())
+}
+
+func TestSequencePatternRule_ErrorObject(t *testing.T) {
+	r := SequencePattern(nil, "")
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
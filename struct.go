@@ -28,6 +28,7 @@ type (
 	FieldRules struct {
 		fieldPtr interface{}
 		rules    []Rule
+		all      bool
 	}
 )
 
@@ -68,6 +69,24 @@ func ValidateStruct(structPtr interface{}, fields ...*FieldRules) error {
 // validate struct fields with the provided context.
 // Please refer to ValidateStruct for the detailed instructions on how to use this function.
 func ValidateStructWithContext(ctx context.Context, structPtr interface{}, fields ...*FieldRules) error {
+	return validateStruct(ctx, ErrorTag, structPtr, fields...)
+}
+
+// ValidateStructWithTagName validates a struct like ValidateStruct, but uses the given struct tag instead of ErrorTag
+// to determine the error keys. For example, with the tag name "json", the error of a field tagged with
+// `json:"street_address,omitempty"` is keyed by "street_address". If a field has no such tag, or the tag is "-",
+// its field name is used. An empty tag name makes all errors keyed by field names.
+func ValidateStructWithTagName(structPtr interface{}, tagName string, fields ...*FieldRules) error {
+	return validateStruct(nil, tagName, structPtr, fields...)
+}
+
+// ValidateStructWithTagNameAndContext validates a struct like ValidateStructWithTagName, with the given context.
+func ValidateStructWithTagNameAndContext(ctx context.Context, structPtr interface{}, tagName string, fields ...*FieldRules) error {
+	return validateStruct(ctx, tagName, structPtr, fields...)
+}
+
+// validateStruct validates a struct with the given context, using the given struct tag to name the errors.
+func validateStruct(ctx context.Context, tagName string, structPtr interface{}, fields ...*FieldRules) error {
 	value := reflect.ValueOf(structPtr)
 	if value.Kind() != reflect.Ptr || !value.IsNil() && value.Elem().Kind() != reflect.Struct {
 		// must be a pointer to a struct
@@ -90,26 +109,8 @@ func ValidateStructWithContext(ctx context.Context, structPtr interface{}, field
 		if ft == nil {
 			return NewInternalError(ErrFieldNotFound(i))
 		}
-		var err error
-		if ctx == nil {
-			err = Validate(fv.Elem().Interface(), fr.rules...)
-		} else {
-			err = ValidateWithContext(ctx, fv.Elem().Interface(), fr.rules...)
-		}
-		if err != nil {
-			if ie, ok := err.(InternalError); ok && ie.InternalError() != nil {
-				return err
-			}
-			if ft.Anonymous {
-				// merge errors from anonymous struct field
-				if es, ok := err.(Errors); ok {
-					for name, value := range es {
-						errs[name] = value
-					}
-					continue
-				}
-			}
-			errs[getErrorFieldName(ft)] = err
+		if err := validateStructField(ctx, tagName, structPtr, fv.Elem().Interface(), ft, fr, errs); err != nil {
+			return err
 		}
 	}
 
@@ -119,6 +120,37 @@ func ValidateStructWithContext(ctx context.Context, structPtr interface{}, field
 	return nil
 }
 
+// validateStructField validates the value of a struct field with the given rules and records the validation error,
+// if any, in errs. The errors of an anonymous struct field are merged into errs. Internal errors are returned.
+func validateStructField(ctx context.Context, tagName string, structPtr interface{}, value interface{}, ft *reflect.StructField, fr *FieldRules, errs Errors) error {
+	rules := bindStruct(fr.rules, structPtr, tagName)
+	var err error
+	if fr.all {
+		err = validateAll(ctx, value, rules)
+	} else if ctx == nil {
+		err = Validate(value, rules...)
+	} else {
+		err = ValidateWithContext(ctx, value, rules...)
+	}
+	if err == nil {
+		return nil
+	}
+	if ie, ok := err.(InternalError); ok && ie.InternalError() != nil {
+		return err
+	}
+	if ft.Anonymous {
+		// merge errors from anonymous struct field
+		if es, ok := err.(Errors); ok {
+			for name, value := range es {
+				errs[name] = value
+			}
+			return nil
+		}
+	}
+	errs[getErrorFieldName(ft, tagName)] = err
+	return nil
+}
+
 // Field specifies a struct field and the corresponding validation rules.
 // The struct field must be specified as a pointer to it.
 func Field(fieldPtr interface{}, rules ...Rule) *FieldRules {
@@ -128,6 +160,43 @@ func Field(fieldPtr interface{}, rules ...Rule) *FieldRules {
 	}
 }
 
+// FieldAll specifies a struct field and the corresponding validation rules like Field, but evaluates all rules
+// instead of stopping at the first failing one. The errors of the failing rules are reported for the field as
+// FieldErrors, in the order of the rules. Skip, NotRequired and Sensitive work the same way as with Field.
+// For example,
+//
+//    validation.FieldAll(&f.Password, validation.Required, validation.Length(8, 0), validation.Match(reDigit))
+//    // Password: the length must be no less than 8, must be in a valid format.
+func FieldAll(fieldPtr interface{}, rules ...Rule) *FieldRules {
+	fr := Field(fieldPtr, rules...)
+	fr.all = true
+	return fr
+}
+
+// structBinder is implemented by rules that need the struct being validated by ValidateStruct.
+type structBinder interface {
+	// bindStruct returns a copy of the rule bound to the given struct.
+	bindStruct(structPtr interface{}, tagName string) Rule
+}
+
+// bindStruct returns the given rules with the rules implementing structBinder bound to the struct being validated.
+// The rules of the FieldRules are not modified, so they can be reused with other structs.
+func bindStruct(rules []Rule, structPtr interface{}, tagName string) []Rule {
+	var bound []Rule
+	for i, rule := range rules {
+		if b, ok := rule.(structBinder); ok {
+			if bound == nil {
+				bound = append([]Rule(nil), rules...)
+			}
+			bound[i] = b.bindStruct(structPtr, tagName)
+		}
+	}
+	if bound == nil {
+		return rules
+	}
+	return bound
+}
+
 // findStructField looks for a field in the given struct.
 // The field being looked for should be a pointer to the actual struct field.
 // If found, the field info will be returned. Otherwise, nil will be returned.
@@ -159,8 +228,8 @@ func findStructField(structValue reflect.Value, fieldValue reflect.Value) *refle
 }
 
 // getErrorFieldName returns the name that should be used to represent the validation error of a struct field.
-func getErrorFieldName(f *reflect.StructField) string {
-	if tag := f.Tag.Get(ErrorTag); tag != "" && tag != "-" {
+func getErrorFieldName(f *reflect.StructField, tagName string) string {
+	if tag := f.Tag.Get(tagName); tagName != "" && tag != "" && tag != "-" {
 		if cps := strings.SplitN(tag, ",", 2); cps[0] != "" {
 			return cps[0]
 		}
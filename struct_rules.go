@@ -0,0 +1,153 @@
+package validate
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"unsafe"
+)
+
+// StructRules is a set of field rules that is prepared once and can validate any number of structs of the same type,
+// avoiding the cost of specifying and resolving the fields on every validation.
+type StructRules struct {
+	typ    reflect.Type
+	fields []structFieldRules
+	err    error
+}
+
+// structFieldRules holds the rules of a struct field identified by its index sequence.
+type structFieldRules struct {
+	index []int
+	field reflect.StructField
+	rules *FieldRules
+}
+
+// NewStructRules prepares the given field rules for validating structs of the same type as the one structPtr
+// points to. The fields are specified like for ValidateStruct, with pointers to the fields of that struct, which
+// serves only as a template: the rules identify the fields by their position, so they apply to any struct of the type.
+// For example,
+//
+//    var u User
+//    var userRules = validation.NewStructRules(&u,
+//        validation.Field(&u.Name, validation.Required, validation.Length(5, 20)),
+//        validation.Field(&u.Email, validation.Required, is.Email),
+//    )
+//
+//    err := userRules.Validate(&user)
+//
+// Rules that refer to other fields through pointers, such as RequiredWith, keep referring to the fields of the
+// template; use ByStruct to access the struct being validated instead.
+// Fields reached through an embedded struct pointer require the pointer of the template to be non-nil. When
+// validating a struct whose embedded pointer is nil, such fields are skipped. Invalid arguments are reported
+// as internal errors by Validate.
+func NewStructRules(structPtr interface{}, fields ...*FieldRules) *StructRules {
+	value := reflect.ValueOf(structPtr)
+	if value.Kind() != reflect.Ptr || value.IsNil() || value.Elem().Kind() != reflect.Struct {
+		return &StructRules{err: NewInternalError(ErrStructPointer)}
+	}
+	value = value.Elem()
+
+	r := &StructRules{typ: value.Type(), fields: make([]structFieldRules, len(fields))}
+	for i, fr := range fields {
+		fv := reflect.ValueOf(fr.fieldPtr)
+		if fv.Kind() != reflect.Ptr {
+			return &StructRules{err: NewInternalError(ErrFieldPointer(i))}
+		}
+		index := findStructFieldIndex(value, fv)
+		if index == nil {
+			return &StructRules{err: NewInternalError(ErrFieldNotFound(i))}
+		}
+		r.fields[i] = structFieldRules{index: index, field: r.typ.FieldByIndex(index), rules: fr}
+	}
+	return r
+}
+
+// Validate validates a struct like ValidateStruct, using the prepared field rules.
+// The struct must be specified as a pointer to a struct of the type the rules were prepared for.
+// If the pointer is nil, it is considered valid.
+func (r *StructRules) Validate(structPtr interface{}) error {
+	return r.validate(nil, structPtr)
+}
+
+// ValidateWithContext validates a struct like ValidateStructWithContext, using the prepared field rules.
+func (r *StructRules) ValidateWithContext(ctx context.Context, structPtr interface{}) error {
+	return r.validate(ctx, structPtr)
+}
+
+func (r *StructRules) validate(ctx context.Context, structPtr interface{}) error {
+	if r.err != nil {
+		return r.err
+	}
+	value := reflect.ValueOf(structPtr)
+	if value.Kind() != reflect.Ptr || value.Type().Elem() != r.typ {
+		return NewInternalError(fmt.Errorf("the rules can only validate a pointer to %v", r.typ))
+	}
+	if value.IsNil() {
+		// treat a nil struct pointer as valid
+		return nil
+	}
+	value = value.Elem()
+
+	errs := Errors{}
+	for i := range r.fields {
+		f := &r.fields[i]
+		fv, ok := fieldByIndex(value, f.index)
+		if !ok {
+			// the field belongs to a nil embedded struct pointer
+			continue
+		}
+		if err := validateStructField(ctx, ErrorTag, structPtr, fv, &f.field, f.rules, errs); err != nil {
+			return err
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// findStructFieldIndex returns the index sequence of a field in the given struct, or nil if it cannot be found.
+// The field being looked for should be a pointer to the actual struct field.
+func findStructFieldIndex(structValue reflect.Value, fieldValue reflect.Value) []int {
+	ptr := fieldValue.Pointer()
+	for i := structValue.NumField() - 1; i >= 0; i-- {
+		sf := structValue.Type().Field(i)
+		if ptr == structValue.Field(i).UnsafeAddr() && sf.Type == fieldValue.Elem().Type() {
+			return []int{i}
+		}
+		if sf.Anonymous {
+			fi := structValue.Field(i)
+			if sf.Type.Kind() == reflect.Ptr {
+				fi = fi.Elem()
+			}
+			if fi.Kind() == reflect.Struct {
+				if index := findStructFieldIndex(fi, fieldValue); index != nil {
+					return append([]int{i}, index...)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// fieldByIndex returns the value of the field with the given index sequence in a struct.
+// It returns false if the field is reached through a nil embedded struct pointer.
+// Unlike reflect.Value.Interface, it also returns the values of unexported fields.
+func fieldByIndex(structValue reflect.Value, index []int) (interface{}, bool) {
+	v := structValue
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				return nil, false
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+	if !v.CanInterface() {
+		// the struct is addressable, so the value of an unexported field can be read through its address
+		v = reflect.NewAt(v.Type(), unsafe.Pointer(v.UnsafeAddr())).Elem()
+	}
+	return v.Interface(), true
+}
@@ -0,0 +1,147 @@
+package validate
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type structRulesEmbedded struct {
+	Nickname string
+}
+
+type structRulesUser struct {
+	*structRulesEmbedded
+	Name  string `json:"name"`
+	Age   int
+	email string
+	Tags  []string
+}
+
+func newUserRules() *StructRules {
+	u := structRulesUser{structRulesEmbedded: &structRulesEmbedded{}}
+	return NewStructRules(&u,
+		Field(&u.Name, Required, Length(3, 10)),
+		Field(&u.Age, Min(18)),
+		Field(&u.email, Length(0, 10)),
+		FieldAll(&u.Tags, Count(0, 2), Each(Length(0, 3))),
+		Field(&u.Nickname, Required),
+	)
+}
+
+func TestStructRules(t *testing.T) {
+	rules := newUserRules()
+
+	tests := []struct {
+		tag  string
+		user *structRulesUser
+		err  string
+	}{
+		{"t1", &structRulesUser{Name: "Ann", structRulesEmbedded: &structRulesEmbedded{Nickname: "a"}}, ""},
+		{"t2", &structRulesUser{Name: "A", Age: 10, structRulesEmbedded: &structRulesEmbedded{}},
+			"Age: must be no less than 18; Nickname: cannot be blank; name: the length must be between 3 and 10."},
+		{"t3", &structRulesUser{Name: "Bob", email: "bob@example.com"}, "email: the length must be no more than 10."},
+		{"t4", &structRulesUser{Name: "Bob", Tags: []string{"a", "b", "long"}}, "Tags: must contain no more than 2 items, (2: the length must be no more than 3.)."},
+		{"t5", nil, ""},
+	}
+
+	// the same rules validate different instances
+	for _, test := range tests {
+		assertError(t, test.err, rules.Validate(test.user), test.tag)
+		assertError(t, test.err, rules.ValidateWithContext(context.Background(), test.user), test.tag)
+	}
+
+	// the results match ValidateStruct
+	u := structRulesUser{Name: "A", Age: 10, structRulesEmbedded: &structRulesEmbedded{}}
+	err := ValidateStruct(&u,
+		Field(&u.Name, Required, Length(3, 10)),
+		Field(&u.Age, Min(18)),
+		Field(&u.Nickname, Required),
+	)
+	assert.Equal(t, err.Error(), rules.Validate(&u).Error())
+}
+
+func TestStructRules_Context(t *testing.T) {
+	type key int
+	var s struct{ Name string }
+	rules := NewStructRules(&s, Field(&s.Name, WithContext(func(ctx context.Context, value interface{}) error {
+		if ctx.Value(key(1)) != value {
+			return errors.New("unexpected value")
+		}
+		return nil
+	})))
+
+	s.Name = "abc"
+	ctx := context.WithValue(context.Background(), key(1), "abc")
+	assert.Nil(t, rules.ValidateWithContext(ctx, &s))
+	assert.EqualError(t, rules.Validate(&s), "Name: unexpected value.")
+}
+
+func TestStructRules_ByStruct(t *testing.T) {
+	type order struct {
+		Min, Max int
+	}
+	var o order
+	rules := NewStructRules(&o, Field(&o.Max, ByStruct(func(s interface{}, value interface{}) error {
+		if value.(int) < s.(*order).Min {
+			return errors.New("must not be less than Min")
+		}
+		return nil
+	})))
+
+	assert.Nil(t, rules.Validate(&order{Min: 1, Max: 2}))
+	assert.EqualError(t, rules.Validate(&order{Min: 3, Max: 2}), "Max: must not be less than Min.")
+}
+
+func TestStructRules_Errors(t *testing.T) {
+	var s struct{ Name string }
+	var other struct{ Name string }
+
+	for tag, rules := range map[string]*StructRules{
+		"non-pointer":       NewStructRules(s),
+		"nil":               NewStructRules((*structRulesUser)(nil)),
+		"non-field-pointer": NewStructRules(&s, Field(s.Name)),
+		"unknown-field":     NewStructRules(&s, Field(&other.Name)),
+	} {
+		err := rules.Validate(&s)
+		_, ok := err.(InternalError)
+		assert.True(t, ok, tag)
+	}
+	assert.Equal(t, ErrFieldNotFound(0), NewStructRules(&s, Field(&other.Name)).Validate(&s).(InternalError).InternalError())
+
+	rules := NewStructRules(&s, Field(&s.Name, Required))
+	_, ok := rules.Validate(&structRulesUser{}).(InternalError)
+	assert.True(t, ok)
+	_, ok = rules.Validate(s).(InternalError)
+	assert.True(t, ok)
+
+	// fields of a nil embedded pointer of the template cannot be resolved
+	u := structRulesUser{}
+	assert.Nil(t, NewStructRules(&u, Field(&u.structRulesEmbedded)).Validate(&u))
+}
+
+func BenchmarkValidateStruct(b *testing.B) {
+	u := structRulesUser{Name: "Ann", Age: 20, structRulesEmbedded: &structRulesEmbedded{Nickname: "a"}}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = ValidateStruct(&u,
+			Field(&u.Name, Required, Length(3, 10)),
+			Field(&u.Age, Min(18)),
+			Field(&u.email, Length(0, 10)),
+			FieldAll(&u.Tags, Count(0, 2), Each(Length(0, 3))),
+			Field(&u.Nickname, Required),
+		)
+	}
+}
+
+func BenchmarkStructRules(b *testing.B) {
+	u := structRulesUser{Name: "Ann", Age: 20, structRulesEmbedded: &structRulesEmbedded{Nickname: "a"}}
+	rules := newUserRules()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = rules.Validate(&u)
+	}
+}
@@ -6,7 +6,9 @@ package validate
 
 import (
 	"context"
+	"errors"
 	"reflect"
+	"regexp"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -196,13 +198,209 @@ func Test_getErrorFieldName(t *testing.T) {
 
 	sf1 := findStructField(v1, reflect.ValueOf(&s1.Field1))
 	assert.NotNil(t, sf1)
-	assert.Equal(t, "Field1", getErrorFieldName(sf1))
+	assert.Equal(t, "Field1", getErrorFieldName(sf1, ErrorTag))
 
 	jsonField := findStructField(v1, reflect.ValueOf(&s1.JSONField))
 	assert.NotNil(t, jsonField)
-	assert.Equal(t, "some_json_field", getErrorFieldName(jsonField))
+	assert.Equal(t, "some_json_field", getErrorFieldName(jsonField, ErrorTag))
 
 	jsonIgnoredField := findStructField(v1, reflect.ValueOf(&s1.JSONIgnoredField))
 	assert.NotNil(t, jsonIgnoredField)
-	assert.Equal(t, "JSONIgnoredField", getErrorFieldName(jsonIgnoredField))
+	assert.Equal(t, "JSONIgnoredField", getErrorFieldName(jsonIgnoredField, ErrorTag))
+}
+
+func TestValidateStructWithTagName(t *testing.T) {
+	type Address struct {
+		Street string `json:"street_address,omitempty" yaml:"street"`
+		City   string `json:"-" yaml:"city"`
+	}
+	type Customer struct {
+		Name string `json:"name" yaml:"full_name"`
+		Zip  string
+		Address
+	}
+	c := Customer{}
+	fields := func() []*FieldRules {
+		return []*FieldRules{
+			Field(&c.Name, Required),
+			Field(&c.Zip, Required),
+			Field(&c.Street, Required),
+			Field(&c.Address.City, Required),
+		}
+	}
+
+	tests := []struct {
+		tag     string
+		tagName string
+		err     string
+	}{
+		{"t1", "json", "City: cannot be blank; Zip: cannot be blank; name: cannot be blank; street_address: cannot be blank."},
+		{"t2", "yaml", "Zip: cannot be blank; city: cannot be blank; full_name: cannot be blank; street: cannot be blank."},
+		{"t3", "", "City: cannot be blank; Name: cannot be blank; Street: cannot be blank; Zip: cannot be blank."},
+		{"t4", "xml", "City: cannot be blank; Name: cannot be blank; Street: cannot be blank; Zip: cannot be blank."},
+	}
+
+	for _, test := range tests {
+		err := ValidateStructWithTagName(&c, test.tagName, fields()...)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	err := ValidateStructWithTagNameAndContext(context.Background(), &c, "yaml", Field(&c.Name, Required))
+	assertError(t, "full_name: cannot be blank.", err, "context")
+
+	c.Name, c.Zip, c.Street, c.City = "a", "b", "c", "d"
+	assert.Nil(t, ValidateStructWithTagName(&c, "json", fields()...))
+	assert.NotNil(t, ValidateStructWithTagName(c, "json"))
+}
+
+func TestFieldAll(t *testing.T) {
+	type form struct {
+		Name     string
+		Password string
+		Email    string
+	}
+	reDigit := regexp.MustCompile(`\d`)
+	validate := func(f *form) error {
+		return ValidateStruct(f,
+			Field(&f.Name, Required, Length(3, 0)),
+			FieldAll(&f.Password, Required, Length(8, 0), Match(reDigit)),
+			FieldAll(&f.Email, NotRequired, Length(0, 5), In("a@b.c")),
+		)
+	}
+
+	tests := []struct {
+		tag  string
+		form form
+		err  string
+	}{
+		{"t1", form{Name: "Ann", Password: "secret123"}, ""},
+		{"t2", form{Name: "Ann", Password: "secret"}, "Password: the length must be no less than 8, must be in a valid format."},
+		{"t3", form{Name: "Ann", Password: "secretive"}, "Password: must be in a valid format."},
+		{"t4", form{Name: "Ann"}, "Password: cannot be blank."},
+		{"t5", form{Name: "Ann", Password: "secret123", Email: "abc@example.com"}, "Email: the length must be no more than 5, must be a valid value."},
+	}
+
+	for _, test := range tests {
+		err := validate(&test.form)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	// the errors are collected in the order of the rules
+	f := form{Name: "Ann", Password: "secret"}
+	err := ValidateStruct(&f, FieldAll(&f.Password, Match(reDigit), Length(8, 0), Required))
+	if assert.NotNil(t, err) {
+		errs := err.(Errors)["Password"].(FieldErrors)
+		if assert.Len(t, errs, 2) {
+			assert.Equal(t, "validation_match_invalid", errs[0].(Error).Code())
+			assert.Equal(t, ErrLengthTooShort.Code(), errs[1].(Error).Code())
+			assert.Equal(t, "secret", errs[1].(ErrorObject).Value())
+		}
+	}
+
+	// Skip stops the evaluation, keeping the errors found so far
+	err = ValidateStruct(&f, FieldAll(&f.Password, Length(8, 0), Skip, Match(reDigit)))
+	assert.EqualError(t, err, "Password: the length must be no less than 8.")
+
+	// context-aware rules are evaluated with the context
+	m := Model1{A: "abc"}
+	err = ValidateStructWithContext(context.Background(), &m, FieldAll(&m.A, Required, WithContext(func(ctx context.Context, value interface{}) error {
+		return errors.New("from context")
+	}), Length(0, 2)))
+	assert.EqualError(t, err, "A: from context, the length must be no more than 2.")
+
+	// internal errors are returned immediately
+	err = ValidateStruct(&f, FieldAll(&f.Password, Length(8, 0), MultipleOf(0)))
+	_, ok := err.(InternalError)
+	assert.True(t, ok)
+}
+
+type embeddedEmployee struct {
+	Name string
+}
+
+func (e embeddedEmployee) Validate() error {
+	return ValidateStruct(&e, Field(&e.Name, Required))
+}
+
+func TestValidateStruct_NilEmbeddedPointer(t *testing.T) {
+	type manager struct {
+		*embeddedEmployee
+		Level int
+	}
+
+	// fields of a nil embedded pointer cannot be addressed, so the embedded pointer itself is validated
+	m := manager{}
+	assert.Nil(t, ValidateStruct(&m, Field(&m.embeddedEmployee), Field(&m.Level)))
+	assert.EqualError(t, ValidateStruct(&m, Field(&m.embeddedEmployee, ZeroIfNil), Field(&m.Level, Required)),
+		"Level: cannot be blank; Name: cannot be blank.")
+	assert.EqualError(t, ValidateStruct(&m, Field(&m.embeddedEmployee, Required)), "embeddedEmployee: cannot be blank.")
+	assert.Nil(t, m.embeddedEmployee, "ZeroIfNil does not modify the struct")
+
+	// looking up other fields does not dereference the nil embedded pointer
+	assert.EqualError(t, ValidateStruct(&m, Field(&m.Level, Required)), "Level: cannot be blank.")
+
+	m = manager{embeddedEmployee: &embeddedEmployee{}, Level: 1}
+	assert.EqualError(t, ValidateStruct(&m, Field(&m.embeddedEmployee)), "Name: cannot be blank.")
+	assert.EqualError(t, ValidateStruct(&m, Field(&m.embeddedEmployee, ZeroIfNil)), "Name: cannot be blank.")
+	assert.EqualError(t, ValidateStruct(&m, Field(&m.Name, Required)), "Name: cannot be blank.")
+
+	m.Name = "Ann"
+	assert.Nil(t, ValidateStruct(&m, Field(&m.embeddedEmployee, ZeroIfNil), Field(&m.Name, Required)))
+	assert.Nil(t, ValidateStructWithContext(context.Background(), &m, FieldAll(&m.embeddedEmployee, ZeroIfNil)))
+
+	// the zero value is also validated with a context and with FieldAll
+	m = manager{Level: 1}
+	assert.EqualError(t, ValidateStructWithContext(context.Background(), &m, Field(&m.embeddedEmployee, ZeroIfNil)), "Name: cannot be blank.")
+	assert.EqualError(t, ValidateStruct(&m, FieldAll(&m.embeddedEmployee, ZeroIfNil)), "Name: cannot be blank.")
+}
+
+func TestValidateStruct_PointerFields(t *testing.T) {
+	type form struct {
+		Name  *string
+		Code  *string
+		Count *int
+	}
+	validate := func(f *form, nameRules ...Rule) error {
+		return ValidateStruct(f,
+			Field(&f.Name, nameRules...),
+			Field(&f.Code, Length(2, 3), Match(regexp.MustCompile(`^[A-Z]+$`)), In("AB", "ABC")),
+			Field(&f.Count, Min(1), Max(10), MultipleOf(2), NotIn(4)),
+		)
+	}
+	str := func(s string) *string { return &s }
+	num := func(n int) *int { return &n }
+
+	tests := []struct {
+		tag   string
+		form  form
+		rules []Rule
+		err   string
+	}{
+		// nil pointers are skipped by the value rules
+		{"t1", form{}, nil, ""},
+		// pointers to zero values are considered empty
+		{"t2", form{Name: str(""), Code: str(""), Count: num(0)}, nil, ""},
+		// pointers to other values are dereferenced
+		{"t3", form{Code: str("AB"), Count: num(2)}, nil, ""},
+		{"t4", form{Code: str("A")}, nil, "Code: the length must be between 2 and 3."},
+		{"t5", form{Code: str("ab")}, nil, "Code: must be in a valid format."},
+		{"t6", form{Code: str("XY")}, nil, "Code: must be a valid value."},
+		{"t7", form{Count: num(12)}, nil, "Count: must be no greater than 10."},
+		{"t8", form{Count: num(3)}, nil, "Count: must be a multiple of 2."},
+		{"t9", form{Count: num(4)}, nil, "Count: must not be in list."},
+		// Required rejects both nil pointers and pointers to zero values
+		{"t10", form{}, []Rule{Required}, "Name: cannot be blank."},
+		{"t11", form{Name: str("")}, []Rule{Required}, "Name: cannot be blank."},
+		{"t12", form{Name: str("a")}, []Rule{Required}, ""},
+		// NotNil and NilOrNotEmpty tell them apart
+		{"t13", form{}, []Rule{NotNil}, "Name: is required."},
+		{"t14", form{Name: str("")}, []Rule{NotNil}, ""},
+		{"t15", form{}, []Rule{NilOrNotEmpty}, ""},
+		{"t16", form{Name: str("")}, []Rule{NilOrNotEmpty}, "Name: cannot be blank."},
+	}
+
+	for _, test := range tests {
+		err := validate(&test.form, test.rules...)
+		assertError(t, test.err, err, test.tag)
+	}
 }
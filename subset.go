@@ -0,0 +1,90 @@
+package validate
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ErrNotSubset is the error that returns when a slice contains values that are not in the allowed set.
+var ErrNotSubset = NewError("validation_not_subset", "contains values that are not allowed: {{.values}}")
+
+// SubsetOf returns a validation rule that checks if every element of a slice or array can be found in
+// the allowed slice or array. reflect.DeepEqual() is used to determine if two values are equal.
+// The offending values are listed in the "values" parameter of the error in the order they first appear.
+// If allowed is not a slice or an array, the rule returns an internal error.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func SubsetOf(allowed interface{}) SubsetRule {
+	return SubsetRule{
+		allowed: allowed,
+		err:     ErrNotSubset,
+	}
+}
+
+// SubsetRule is a validation rule that checks if a slice is a subset of an allowed set of values.
+type SubsetRule struct {
+	allowed interface{}
+	err     Error
+}
+
+// Validate checks if the given value is valid or not.
+func (r SubsetRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	v, err := sliceValue(value)
+	if err != nil {
+		return err
+	}
+
+	allowed, err := sliceValue(r.allowed)
+	if err != nil {
+		return NewInternalError(fmt.Errorf("allowed values must be a slice or an array, got %T", r.allowed))
+	}
+
+	var invalid []interface{}
+	for i := 0; i < v.Len(); i++ {
+		e := v.Index(i).Interface()
+		if !containsValue(allowed, e) && !containsElem(invalid, e) {
+			invalid = append(invalid, e)
+		}
+	}
+
+	if len(invalid) == 0 {
+		return nil
+	}
+	values := make([]string, len(invalid))
+	for i, e := range invalid {
+		values[i] = fmt.Sprint(e)
+	}
+	return r.err.SetParams(map[string]interface{}{"values": strings.Join(values, ", ")})
+}
+
+// containsValue reports whether the slice or array v has an element deeply equal to e.
+func containsValue(v reflect.Value, e interface{}) bool {
+	for i := 0; i < v.Len(); i++ {
+		if reflect.DeepEqual(v.Index(i).Interface(), e) {
+			return true
+		}
+	}
+	return false
+}
+
+// containsElem reports whether values has an element deeply equal to e.
+func containsElem(values []interface{}, e interface{}) bool {
+	return containsValue(reflect.ValueOf(values), e)
+}
+
+// Error sets the error message for the rule.
+func (r SubsetRule) Error(message string) SubsetRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r SubsetRule) ErrorObject(err Error) SubsetRule {
+	r.err = err
+	return r
+}
@@ -0,0 +1,57 @@
+package validate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubsetOf(t *testing.T) {
+	var nilSlice []string
+	allowed := []string{"red", "green", "blue"}
+
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", nilSlice, ""},
+		{"t2", []string{}, ""},
+		{"t3", []string{"red", "blue"}, ""},
+		{"t4", []string{"red", "red"}, ""},
+		{"t5", []string{"red", "x", "y", "x"}, "contains values that are not allowed: x, y"},
+		{"t6", [1]string{"green"}, ""},
+		{"t7", &[]string{"pink"}, "contains values that are not allowed: pink"},
+		{"t8", []int{1}, "contains values that are not allowed: 1"},
+		{"t9", "red", "must be a slice or an array"},
+	}
+
+	for _, test := range tests {
+		err := SubsetOf(allowed).Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestSubsetOf_InvalidAllowed(t *testing.T) {
+	err := SubsetOf("red").Validate([]string{"red"})
+	if assert.NotNil(t, err) {
+		_, ok := err.(InternalError)
+		assert.True(t, ok)
+		assert.Equal(t, "allowed values must be a slice or an array, got string", err.Error())
+	}
+}
+
+func TestSubsetRule_Error(t *testing.T) {
+	r := SubsetOf([]int{1}).Error("unknown: {{.values}}")
+	assert.Equal(t, "unknown: 2, 3", r.Validate([]int{2, 1, 3}).Error())
+}
+
+func TestSubsetRule_ErrorObject(t *testing.T) {
+	r := SubsetOf(nil)
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
@@ -0,0 +1,91 @@
+package validate
+
+import "math"
+
+// ErrSumEquals is the error that returns when the sum of a slice does not equal a total.
+var ErrSumEquals = NewError("validation_sum_equals", "total does not equal the sum of the items")
+
+// defaultSumTolerance is the absolute tolerance used to compare a sum against its total.
+const defaultSumTolerance = 1e-9
+
+// SumEquals returns a validation rule that checks if the sum of a slice or array equals a total.
+// totalPtr should be a pointer to a sibling numeric field holding the declared total, which makes the rule
+// usable within ValidateStruct. For example,
+//    validation.Field(&doc.LineItems, validation.SumEquals(&doc.Total, func(v interface{}) float64 {
+//        return v.(LineItem).Amount
+//    }))
+//
+// The extractor function returns the amount contributed by each element. If it is nil, the elements
+// themselves must be of int, uint or float types.
+// The sum and the total are compared within an absolute tolerance, which can be changed by calling Tolerance.
+// Because an empty or nil slice sums to zero, it is only valid if the total is zero as well.
+func SumEquals(totalPtr interface{}, extractor func(interface{}) float64) SumEqualsRule {
+	return SumEqualsRule{
+		total:     totalPtr,
+		extractor: extractor,
+		tolerance: defaultSumTolerance,
+		err:       ErrSumEquals,
+	}
+}
+
+// SumEqualsRule is a validation rule that checks if the sum of a slice equals a total.
+type SumEqualsRule struct {
+	total     interface{}
+	extractor func(interface{}) float64
+	tolerance float64
+	err       Error
+}
+
+// Tolerance sets the absolute tolerance within which the sum and the total are considered equal.
+func (r SumEqualsRule) Tolerance(tolerance float64) SumEqualsRule {
+	r.tolerance = tolerance
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r SumEqualsRule) Validate(value interface{}) error {
+	var sum float64
+	if value, isNil := Indirect(value); !isNil {
+		v, err := sliceValue(value)
+		if err != nil {
+			return err
+		}
+		for i := 0; i < v.Len(); i++ {
+			e := v.Index(i).Interface()
+			if r.extractor != nil {
+				sum += r.extractor(e)
+				continue
+			}
+			n, err := ToNumber(e)
+			if err != nil {
+				return err
+			}
+			sum += n
+		}
+	}
+
+	var total float64
+	if t, isNil := Indirect(r.total); !isNil {
+		var err error
+		if total, err = ToNumber(t); err != nil {
+			return err
+		}
+	}
+
+	if math.Abs(sum-total) <= r.tolerance {
+		return nil
+	}
+	return r.err
+}
+
+// Error sets the error message for the rule.
+func (r SumEqualsRule) Error(message string) SumEqualsRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r SumEqualsRule) ErrorObject(err Error) SumEqualsRule {
+	r.err = err
+	return r
+}
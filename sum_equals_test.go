@@ -0,0 +1,83 @@
+package validate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type lineItem struct {
+	Amount float64
+}
+
+func lineItemAmount(v interface{}) float64 {
+	return v.(lineItem).Amount
+}
+
+func TestSumEquals(t *testing.T) {
+	total := 30.0
+	zero := 0
+	str := "abc"
+	var nilSlice []int
+
+	tests := []struct {
+		tag       string
+		total     interface{}
+		extractor func(interface{}) float64
+		value     interface{}
+		err       string
+	}{
+		{"t1", &total, nil, []int{10, 20}, ""},
+		{"t2", &total, nil, []float64{10.1, 19.9}, ""},
+		{"t3", &total, nil, []int{10, 21}, "total does not equal the sum of the items"},
+		{"t4", &total, lineItemAmount, []lineItem{{10}, {20}}, ""},
+		{"t5", &total, lineItemAmount, []lineItem{{10}}, "total does not equal the sum of the items"},
+		{"t6", &zero, nil, []int{}, ""},
+		{"t7", &zero, nil, nilSlice, ""},
+		{"t8", &total, nil, nilSlice, "total does not equal the sum of the items"},
+		{"t9", nil, nil, []int{1, -1}, ""},
+		{"t10", &total, nil, []string{"a"}, "cannot convert string to a number"},
+		{"t11", &str, nil, []int{1}, "cannot convert string to a number"},
+		{"t12", &total, nil, 30, "must be a slice or an array"},
+	}
+
+	for _, test := range tests {
+		r := SumEquals(test.total, test.extractor)
+		err := r.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	assert.Nil(t, SumEquals(&total, nil).Tolerance(0.01).Validate([]float64{10, 19.995}))
+	assert.NotNil(t, SumEquals(&total, nil).Validate([]float64{10, 19.995}))
+}
+
+func TestSumEquals_ValidateStruct(t *testing.T) {
+	doc := struct {
+		LineItems []lineItem
+		Total     float64
+	}{LineItems: []lineItem{{10}, {5}}, Total: 16}
+
+	err := ValidateStruct(&doc, Field(&doc.LineItems, SumEquals(&doc.Total, lineItemAmount)))
+	assert.EqualError(t, err, "LineItems: total does not equal the sum of the items.")
+
+	doc.Total = 15
+	assert.Nil(t, ValidateStruct(&doc, Field(&doc.LineItems, SumEquals(&doc.Total, lineItemAmount))))
+}
+
+func TestSumEqualsRule_Error(t *testing.T) {
+	r := SumEquals(nil, nil)
+	assert.Equal(t, "total does not equal the sum of the items", r.Validate([]int{1}).Error())
+
+	r = r.Error("123")
+	assert.Equal(t, "123", r.err.Message())
+}
+
+func TestSumEqualsRule_ErrorObject(t *testing.T) {
+	r := SumEquals(nil, nil)
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
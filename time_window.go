@@ -0,0 +1,82 @@
+package validate
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+)
+
+var (
+	// ErrTimeInFuture is the error that returns when a time is later than now.
+	ErrTimeInFuture = NewError("validation_time_in_future", "must not be in the future")
+	// ErrTimeInPast is the error that returns when a time is earlier than now.
+	ErrTimeInPast = NewError("validation_time_in_past", "must not be in the past")
+	// ErrTimeNotWithin is the error that returns when a time is outside of a window around now.
+	ErrTimeNotWithin = NewError("validation_time_not_within", "must be within {{.past}} before and {{.future}} after now")
+)
+
+var (
+	// Now returns the current time for the rules comparing times with it, such as NotFuture, NotPast
+	// and TimeWithin. It defaults to time.Now and may be replaced, e.g. to make tests deterministic.
+	Now = time.Now
+
+	// NotFuture is a validation rule that checks if a time.Time value is not later than Now().
+	// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+	NotFuture = TimeWindowRule{hasFuture: true, err: ErrTimeInFuture}
+
+	// NotPast is a validation rule that checks if a time.Time value is not earlier than Now().
+	// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+	NotPast = TimeWindowRule{hasPast: true, err: ErrTimeInPast}
+)
+
+// TimeWithin returns a validation rule that checks if a time.Time value is no earlier than past before Now()
+// and no later than future after it, e.g. TimeWithin(30*24*time.Hour, 0) for the last 30 days.
+// The bounds are inclusive, and Now() is called once for every validated value.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func TimeWithin(past, future time.Duration) TimeWindowRule {
+	return TimeWindowRule{
+		past:      past,
+		future:    future,
+		hasPast:   true,
+		hasFuture: true,
+		err:       ErrTimeNotWithin.SetParams(map[string]interface{}{"past": past.String(), "future": future.String()}),
+	}
+}
+
+// TimeWindowRule is a validation rule that checks if a time is within a window around the current time.
+type TimeWindowRule struct {
+	past, future       time.Duration
+	hasPast, hasFuture bool
+	err                Error
+}
+
+// Validate checks if the given value is valid or not.
+func (r TimeWindowRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	t, ok := value.(time.Time)
+	if !ok {
+		return fmt.Errorf("cannot convert %v to time.Time", reflect.TypeOf(value))
+	}
+
+	now := Now()
+	if r.hasPast && t.Before(now.Add(-r.past)) || r.hasFuture && t.After(now.Add(r.future)) {
+		return r.err
+	}
+	return nil
+}
+
+// Error sets the error message for the rule.
+func (r TimeWindowRule) Error(message string) TimeWindowRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r TimeWindowRule) ErrorObject(err Error) TimeWindowRule {
+	r.err = err
+	return r
+}
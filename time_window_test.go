@@ -0,0 +1,82 @@
+package validate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fixNow makes Now return the given time until the returned function is called.
+func fixNow(now time.Time) func() {
+	Now = func() time.Time { return now }
+	return func() { Now = time.Now }
+}
+
+func TestTimeWindow(t *testing.T) {
+	now := time.Date(2020, 5, 1, 12, 0, 0, 0, time.UTC)
+	defer fixNow(now)()
+
+	var nilTime *time.Time
+	tests := []struct {
+		tag   string
+		rule  TimeWindowRule
+		value interface{}
+		err   string
+	}{
+		{"t1", NotFuture, now, ""},
+		{"t2", NotFuture, now.Add(-time.Hour * 24 * 365), ""},
+		{"t3", NotFuture, now.Add(time.Nanosecond), "must not be in the future"},
+		{"t4", NotPast, now, ""},
+		{"t5", NotPast, now.Add(time.Hour * 24 * 365), ""},
+		{"t6", NotPast, now.Add(-time.Nanosecond), "must not be in the past"},
+		{"t7", TimeWithin(time.Hour, 0), now, ""},
+		{"t8", TimeWithin(time.Hour, 0), now.Add(-time.Hour), ""},
+		{"t9", TimeWithin(time.Hour, 0), now.Add(-time.Hour - time.Nanosecond), "must be within 1h0m0s before and 0s after now"},
+		{"t10", TimeWithin(time.Hour, 0), now.Add(time.Nanosecond), "must be within 1h0m0s before and 0s after now"},
+		{"t11", TimeWithin(0, 30*time.Minute), now.Add(30 * time.Minute), ""},
+		{"t12", TimeWithin(0, 30*time.Minute), now.Add(31 * time.Minute), "must be within 0s before and 30m0s after now"},
+		// the location of the value does not matter
+		{"t13", NotFuture, now.In(time.FixedZone("UTC+2", 2*60*60)), ""},
+		{"t14", NotFuture, time.Time{}, ""},
+		{"t15", NotFuture, nilTime, ""},
+		{"t16", NotFuture, "2020-05-01", "cannot convert string to time.Time"},
+	}
+
+	for _, test := range tests {
+		err := test.rule.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestTimeWindow_Now(t *testing.T) {
+	now := time.Date(2020, 5, 1, 12, 0, 0, 0, time.UTC)
+	restore := fixNow(now)
+	value := now.Add(time.Minute)
+	assert.NotNil(t, NotFuture.Validate(value))
+
+	// the clock is read on each validation
+	Now = func() time.Time { return now.Add(time.Hour) }
+	assert.Nil(t, NotFuture.Validate(value))
+
+	restore()
+	assert.Nil(t, NotFuture.Validate(time.Now().Add(-time.Second)))
+	assert.NotNil(t, NotFuture.Validate(time.Now().Add(time.Hour)))
+}
+
+func TestTimeWindowRule_Error(t *testing.T) {
+	r := NotFuture.Error("123")
+	assert.Equal(t, "123", r.err.Message())
+	assert.Equal(t, "must not be in the future", NotFuture.err.Message())
+}
+
+func TestTimeWindowRule_ErrorObject(t *testing.T) {
+	r := TimeWithin(time.Hour, time.Hour)
+
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
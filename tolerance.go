@@ -0,0 +1,77 @@
+package validate
+
+import "math"
+
+// ErrWithinTolerance is the error that returns when a value deviates too much from the expected value.
+var ErrWithinTolerance = NewError("validation_within_tolerance", "must be within {{.percent}}% of the expected value")
+
+// defaultToleranceEpsilon is the absolute tolerance used when the expected value is zero.
+const defaultToleranceEpsilon = 1e-9
+
+// WithinTolerance returns a validation rule that checks if a number is within the given percentage
+// of the expected value, i.e. |value-expected|/|expected| <= percent/100.
+// expected may be a number or a pointer to a sibling field, which makes the rule usable within ValidateStruct.
+// Because a relative tolerance is meaningless for an expected value of zero, the value is then
+// compared against an absolute epsilon instead, which can be changed by calling Epsilon.
+// Both values must be of int, uint or float types.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func WithinTolerance(expected interface{}, percent float64) ToleranceRule {
+	return ToleranceRule{
+		expected: expected,
+		percent:  percent,
+		epsilon:  defaultToleranceEpsilon,
+		err:      ErrWithinTolerance,
+	}
+}
+
+// ToleranceRule is a validation rule that checks if a number is within a percentage of an expected value.
+type ToleranceRule struct {
+	expected interface{}
+	percent  float64
+	epsilon  float64
+	err      Error
+}
+
+// Epsilon sets the absolute tolerance that is used when the expected value is zero.
+func (r ToleranceRule) Epsilon(epsilon float64) ToleranceRule {
+	r.epsilon = epsilon
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r ToleranceRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	v, err := ToNumber(value)
+	if err != nil {
+		return err
+	}
+
+	var e float64
+	if expected, isNil := Indirect(r.expected); !isNil {
+		if e, err = ToNumber(expected); err != nil {
+			return err
+		}
+	}
+
+	diff := math.Abs(v - e)
+	if e == 0 && diff <= r.epsilon || e != 0 && diff/math.Abs(e) <= r.percent/100 {
+		return nil
+	}
+	return r.err.SetParams(map[string]interface{}{"percent": r.percent})
+}
+
+// Error sets the error message for the rule.
+func (r ToleranceRule) Error(message string) ToleranceRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r ToleranceRule) ErrorObject(err Error) ToleranceRule {
+	r.err = err
+	return r
+}
@@ -0,0 +1,74 @@
+package validate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithinTolerance(t *testing.T) {
+	computed := 200.0
+	var nilPtr *float64
+	str := "abc"
+
+	tests := []struct {
+		tag      string
+		expected interface{}
+		value    interface{}
+		err      string
+	}{
+		{"t1", 100, 0, ""},
+		{"t2", 100, 100, ""},
+		{"t3", 100, 101, ""},
+		{"t4", 100, 99.0, ""},
+		{"t5", 100, 101.5, "must be within 1% of the expected value"},
+		{"t6", -100, -101, ""},
+		{"t7", -100, -98, "must be within 1% of the expected value"},
+		{"t8", &computed, 202, ""},
+		{"t9", &computed, 203, "must be within 1% of the expected value"},
+		{"t10", 0, 1e-10, ""},
+		{"t11", 0, 0.001, "must be within 1% of the expected value"},
+		{"t12", nilPtr, 0.001, "must be within 1% of the expected value"},
+		{"t13", 100, "abc", "cannot convert string to a number"},
+		{"t14", &str, 1, "cannot convert string to a number"},
+	}
+
+	for _, test := range tests {
+		r := WithinTolerance(test.expected, 1)
+		err := r.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	assert.Nil(t, WithinTolerance(0, 1).Epsilon(0.01).Validate(0.001))
+}
+
+func TestWithinTolerance_ValidateStruct(t *testing.T) {
+	doc := struct {
+		Reported float64
+		Computed float64
+	}{Reported: 105, Computed: 100}
+
+	err := ValidateStruct(&doc, Field(&doc.Reported, WithinTolerance(&doc.Computed, 1)))
+	assert.EqualError(t, err, "Reported: must be within 1% of the expected value.")
+
+	doc.Reported = 100.5
+	assert.Nil(t, ValidateStruct(&doc, Field(&doc.Reported, WithinTolerance(&doc.Computed, 1))))
+}
+
+func TestToleranceRule_Error(t *testing.T) {
+	r := WithinTolerance(100, 5)
+	assert.Equal(t, "must be within 5% of the expected value", r.Validate(10).Error())
+
+	r = r.Error("off by more than {{.percent}}%")
+	assert.Equal(t, "off by more than 5%", r.Validate(10).Error())
+}
+
+func TestToleranceRule_ErrorObject(t *testing.T) {
+	r := WithinTolerance(100, 5)
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
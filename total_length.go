@@ -0,0 +1,69 @@
+package validate
+
+import "unicode/utf8"
+
+// ErrTotalLength is the error that returns when the joined elements of a slice are too long.
+var ErrTotalLength = NewError("validation_total_length", "combined text must not exceed {{.max}} characters")
+
+// TotalLength returns a validation rule that checks if the elements of a slice or array of strings,
+// joined with the given separator, have a total length of at most max characters (runes).
+// This bounds the size of the joined text, which per-element length rules cannot capture.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func TotalLength(max int, sep string) TotalLengthRule {
+	return TotalLengthRule{
+		max: max,
+		sep: sep,
+		err: ErrTotalLength,
+	}
+}
+
+// TotalLengthRule is a validation rule that checks the total length of the joined elements of a slice.
+type TotalLengthRule struct {
+	max int
+	sep string
+	err Error
+}
+
+// Validate checks if the given value is valid or not.
+func (r TotalLengthRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	v, err := sliceValue(value)
+	if err != nil {
+		return err
+	}
+
+	total := utf8.RuneCountInString(r.sep) * (v.Len() - 1)
+	for i := 0; i < v.Len(); i++ {
+		e, isNil := Indirect(v.Index(i).Interface())
+		if isNil {
+			// a nil element joins as an empty string
+			continue
+		}
+		str, err := EnsureString(e)
+		if err != nil {
+			return err
+		}
+		total += utf8.RuneCountInString(str)
+	}
+
+	if total > r.max {
+		return r.err.SetParams(map[string]interface{}{"max": r.max, "length": total})
+	}
+	return nil
+}
+
+// Error sets the error message for the rule.
+func (r TotalLengthRule) Error(message string) TotalLengthRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r TotalLengthRule) ErrorObject(err Error) TotalLengthRule {
+	r.err = err
+	return r
+}
@@ -0,0 +1,51 @@
+package validate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTotalLength(t *testing.T) {
+	var nilSlice []string
+	s := "abc"
+
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", nilSlice, ""},
+		{"t2", []string{}, ""},
+		{"t3", []string{"abcdefghij"}, ""},
+		{"t4", []string{"abcdefghijk"}, "combined text must not exceed 10 characters"},
+		{"t5", []string{"abcd", "efgh"}, ""},
+		{"t6", []string{"abcd", "efghi"}, "combined text must not exceed 10 characters"},
+		{"t7", []string{"äöüä", "ßßßß"}, ""},
+		{"t8", [2][]byte{[]byte("abcd"), []byte("efgh")}, ""},
+		{"t9", []*string{&s, &s, nil}, ""},
+		{"t10", []*string{&s, &s, &s}, "combined text must not exceed 10 characters"},
+		{"t11", []int{1, 2}, "must be either a string or byte slice"},
+		{"t12", "abc", "must be a slice or an array"},
+	}
+
+	for _, test := range tests {
+		err := TotalLength(10, ", ").Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestTotalLengthRule_Error(t *testing.T) {
+	r := TotalLength(5, "").Error("{{.length}} of {{.max}} characters used")
+	assert.Equal(t, "6 of 5 characters used", r.Validate([]string{"abc", "def"}).Error())
+}
+
+func TestTotalLengthRule_ErrorObject(t *testing.T) {
+	r := TotalLength(5, "")
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
@@ -0,0 +1,58 @@
+package validate
+
+import "fmt"
+
+// ErrUnique is the error that returns when a slice contains duplicate values.
+var ErrUnique = NewError("validation_unique", "must contain unique values ({{.value}} is duplicated)")
+
+// Unique is a validation rule that checks if the elements of a slice or array are unique.
+// The element type must be comparable, or, for interface elements, the dynamic types of the elements;
+// otherwise the rule returns an internal error. The first duplicated value is reported in the "value"
+// parameter of the error. Combine it with Each to also validate the elements themselves:
+//    validation.Field(&p.Tags, validation.Unique, validation.Each(validation.Required))
+//
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+var Unique = UniqueRule{err: ErrUnique}
+
+// UniqueRule is a validation rule that checks if the elements of a slice are unique.
+type UniqueRule struct {
+	err Error
+}
+
+// Validate checks if the given value is valid or not.
+func (r UniqueRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	v, err := sliceValue(value)
+	if err != nil {
+		return err
+	}
+
+	seen := make(map[interface{}]bool, v.Len())
+	for i := 0; i < v.Len(); i++ {
+		e, err := comparableElem(v.Index(i))
+		if err != nil {
+			return err
+		}
+		if seen[e] {
+			return r.err.SetParams(map[string]interface{}{"value": fmt.Sprint(e)})
+		}
+		seen[e] = true
+	}
+	return nil
+}
+
+// Error sets the error message for the rule.
+func (r UniqueRule) Error(message string) UniqueRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r UniqueRule) ErrorObject(err Error) UniqueRule {
+	r.err = err
+	return r
+}
@@ -0,0 +1,82 @@
+package validate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnique(t *testing.T) {
+	type point struct{ X, Y int }
+	var nilSlice []string
+
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", nilSlice, ""},
+		{"t2", []string{}, ""},
+		{"t3", []string{"a", "b", "c"}, ""},
+		{"t4", []string{"a", "b", "a", "b"}, "must contain unique values (a is duplicated)"},
+		{"t5", []int{1, 2, 3}, ""},
+		{"t6", [3]int{1, 2, 2}, "must contain unique values (2 is duplicated)"},
+		{"t7", []point{{1, 2}, {2, 1}}, ""},
+		{"t8", []point{{1, 2}, {1, 2}}, "must contain unique values ({1 2} is duplicated)"},
+		{"t9", []interface{}{1, "1", nil}, ""},
+		{"t10", &[]string{"x", "x"}, "must contain unique values (x is duplicated)"},
+		{"t11", "abc", "must be a slice or an array"},
+	}
+
+	for _, test := range tests {
+		err := Unique.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	err := Unique.Validate("abc")
+	_, ok := err.(InternalError)
+	assert.True(t, ok)
+}
+
+func TestUnique_NotComparable(t *testing.T) {
+	type box struct{ V interface{} }
+	values := []interface{}{
+		[][]int{{1}, {1}},
+		[]interface{}{1, []int{1}},
+		[]box{{[]int{1}}, {[]int{1}}},
+		[][1]interface{}{{[]int{1}}},
+	}
+	for _, value := range values {
+		err := Unique.Validate(value)
+		if assert.NotNil(t, err) {
+			_, ok := err.(InternalError)
+			assert.True(t, ok)
+		}
+	}
+
+	// structs holding comparable values in interface fields are compared by value
+	assert.EqualError(t, Unique.Validate([]box{{1}, {"a"}, {1}}), "must contain unique values ({1} is duplicated)")
+}
+
+func TestUnique_Each(t *testing.T) {
+	tags := []string{"a", "", "a"}
+	assert.EqualError(t, Validate(tags, Unique, Each(Required)), "must contain unique values (a is duplicated)")
+
+	tags = []string{"a", ""}
+	assert.EqualError(t, Validate(tags, Unique, Each(Required)), "1: cannot be blank.")
+}
+
+func TestUniqueRule_Error(t *testing.T) {
+	r := Unique.Error("duplicate tag {{.value}}")
+	assert.Equal(t, "duplicate tag a", r.Validate([]string{"a", "a"}).Error())
+	assert.Equal(t, ErrUnique, Unique.err)
+}
+
+func TestUniqueRule_ErrorObject(t *testing.T) {
+	err := NewError("code", "abc")
+	r := Unique.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
@@ -0,0 +1,97 @@
+package validate
+
+import (
+	"fmt"
+	"math"
+)
+
+var (
+	// ErrUnitOutOfRange is the error that returns when a value is out of the range allowed for its unit.
+	ErrUnitOutOfRange = NewError("validation_unit_out_of_range", "value is out of range for unit {{.unit}}")
+	// ErrUnitUnknown is the error that returns when a unit has no known range.
+	ErrUnitUnknown = NewError("validation_unit_unknown", "unit {{.unit}} is not supported")
+)
+
+// UnitBounded returns a validation rule that checks if a numeric value is within the range allowed for its unit.
+// unitPtr should be a pointer to a sibling string field holding the unit, which makes the rule usable
+// within ValidateStruct. The bounds map associates each supported unit with an inclusive [min, max] range.
+// For example,
+//    validation.Field(&t.Value, validation.UnitBounded(&t.Unit, map[string][2]float64{
+//        "C": {-273.15, math.Inf(1)},
+//        "K": {0, math.Inf(1)},
+//    }))
+//
+// A unit that is not listed in the bounds map is reported as unsupported. NaN and infinite values are always
+// out of range, even if a bound is infinite.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func UnitBounded(unitPtr interface{}, bounds map[string][2]float64) UnitRule {
+	return UnitRule{
+		unit:    unitPtr,
+		bounds:  bounds,
+		err:     ErrUnitOutOfRange,
+		unitErr: ErrUnitUnknown,
+	}
+}
+
+// UnitRule is a validation rule that checks if a numeric value is within the range allowed for its unit.
+type UnitRule struct {
+	unit         interface{}
+	bounds       map[string][2]float64
+	err, unitErr Error
+}
+
+// Validate checks if the given value is valid or not.
+func (r UnitRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	v, err := ToNumber(value)
+	if err != nil {
+		return err
+	}
+
+	var unit string
+	if u, isNil := Indirect(r.unit); !isNil {
+		s, ok := u.(string)
+		if !ok {
+			return fmt.Errorf("unit must be a string, got %T", u)
+		}
+		unit = s
+	}
+
+	params := map[string]interface{}{"unit": unit}
+	bounds, ok := r.bounds[unit]
+	if !ok {
+		return r.unitErr.SetParams(params)
+	}
+	if math.IsNaN(v) || math.IsInf(v, 0) || v < bounds[0] || v > bounds[1] {
+		return r.err.SetParams(params)
+	}
+	return nil
+}
+
+// Error sets the error message that is used when the value is out of the range allowed for its unit.
+func (r UnitRule) Error(message string) UnitRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct that is used when the value is out of the range allowed for its unit.
+func (r UnitRule) ErrorObject(err Error) UnitRule {
+	r.err = err
+	return r
+}
+
+// UnitError sets the error message that is used when the unit is not supported.
+func (r UnitRule) UnitError(message string) UnitRule {
+	r.unitErr = r.unitErr.SetMessage(message)
+	return r
+}
+
+// UnitErrorObject sets the error struct that is used when the unit is not supported.
+func (r UnitRule) UnitErrorObject(err Error) UnitRule {
+	r.unitErr = err
+	return r
+}
@@ -0,0 +1,83 @@
+package validate
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var temperatureBounds = map[string][2]float64{
+	"C": {-273.15, math.Inf(1)},
+	"K": {0, math.Inf(1)},
+	"%": {0, 100},
+}
+
+func TestUnitBounded(t *testing.T) {
+	var nilUnit *string
+	celsius, kelvin, percent, unknown := "C", "K", "%", "X"
+	badUnit := 1
+
+	tests := []struct {
+		tag   string
+		unit  interface{}
+		value interface{}
+		err   string
+	}{
+		{"t1", &celsius, 0.0, ""},
+		{"t2", &celsius, -273.15, ""},
+		{"t3", &celsius, -300.0, "value is out of range for unit C"},
+		{"t4", &kelvin, -1.0, "value is out of range for unit K"},
+		{"t5", &kelvin, 300, ""},
+		{"t6", &percent, uint(101), "value is out of range for unit %"},
+		{"t7", &unknown, 1.0, "unit X is not supported"},
+		{"t8", nilUnit, 1.0, "unit  is not supported"},
+		{"t9", &celsius, "abc", "cannot convert string to a number"},
+		{"t10", &badUnit, 1.0, "unit must be a string, got int"},
+		{"t11", &percent, math.NaN(), "value is out of range for unit %"},
+		{"t12", &celsius, math.Inf(1), "value is out of range for unit C"},
+		{"t13", &kelvin, math.Inf(-1), "value is out of range for unit K"},
+	}
+
+	for _, test := range tests {
+		r := UnitBounded(test.unit, temperatureBounds)
+		err := r.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestUnitBounded_ValidateStruct(t *testing.T) {
+	q := struct {
+		Value float64
+		Unit  string
+	}{Value: -5, Unit: "K"}
+
+	err := ValidateStruct(&q, Field(&q.Value, UnitBounded(&q.Unit, temperatureBounds)))
+	assert.EqualError(t, err, "Value: value is out of range for unit K.")
+
+	q.Unit = "C"
+	assert.Nil(t, ValidateStruct(&q, Field(&q.Value, UnitBounded(&q.Unit, temperatureBounds))))
+}
+
+func TestUnitRule_Error(t *testing.T) {
+	unit := "K"
+	r := UnitBounded(&unit, temperatureBounds).Error("bad {{.unit}}")
+	assert.Equal(t, "bad K", r.Validate(-1).Error())
+
+	unit = "X"
+	r = r.UnitError("{{.unit}}?")
+	assert.Equal(t, "X?", r.Validate(-1).Error())
+}
+
+func TestUnitRule_ErrorObject(t *testing.T) {
+	r := UnitBounded(nil, temperatureBounds)
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+
+	r = r.UnitErrorObject(err)
+	assert.Equal(t, err, r.unitErr)
+}
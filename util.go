@@ -8,6 +8,7 @@ import (
 	"database/sql/driver"
 	"errors"
 	"fmt"
+	"math"
 	"reflect"
 	"time"
 )
@@ -78,6 +79,27 @@ func ToUint(value interface{}) (uint64, error) {
 	return 0, fmt.Errorf("cannot convert %v to uint64", v.Kind())
 }
 
+// errIntOverflow is the error that returns when an unsigned integer does not fit into an int64.
+var errIntOverflow = errors.New("value overflows int64")
+
+// toInt64 converts the given signed or unsigned integer value to an int64.
+// errIntOverflow is returned for unsigned values greater than math.MaxInt64, and the error of ToInt
+// for all other incompatible types.
+func toInt64(value interface{}) (int64, error) {
+	v, err := ToInt(value)
+	if err == nil {
+		return v, nil
+	}
+	u, uerr := ToUint(value)
+	if uerr != nil {
+		return 0, err
+	}
+	if u > math.MaxInt64 {
+		return 0, errIntOverflow
+	}
+	return int64(u), nil
+}
+
 // ToFloat converts the given value to a float64.
 // An error is returned for all incompatible types.
 func ToFloat(value interface{}) (float64, error) {
@@ -89,15 +111,50 @@ func ToFloat(value interface{}) (float64, error) {
 	return 0, fmt.Errorf("cannot convert %v to float64", v.Kind())
 }
 
+// ToNumber converts the given int, uint or float value to a float64.
+// An error is returned for all incompatible types.
+func ToNumber(value interface{}) (float64, error) {
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return float64(v.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), nil
+	}
+	return 0, fmt.Errorf("cannot convert %v to a number", v.Kind())
+}
+
+// errNotSlice is the error that returns when a value is expected to be a slice or an array.
+var errNotSlice = errors.New("must be a slice or an array")
+
+// sliceValue returns the reflection value of the given slice or array.
+// An internal error is returned for all other types, as the rules using it can only validate slices and arrays.
+func sliceValue(value interface{}) (reflect.Value, error) {
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+		return v, NewInternalError(errNotSlice)
+	}
+	return v, nil
+}
+
 // IsEmpty checks if a value is empty or not.
-// A value is considered empty if
+// If the value, or any value referenced by it through pointers, implements Emptier, its IsEmpty method
+// decides; a nil pointer is empty without calling the method. Otherwise a value is considered empty if
 // - integer, float: zero
 // - bool: false
 // - string, array: len() == 0
 // - slice, map: nil or len() == 0
 // - interface, pointer: nil or the referenced value is empty
+//
+// Rules usually call IsEmpty on the result of Indirect, which converts a driver.Valuer to the value
+// returned by its Value() method. The Required and NilOrNotEmpty rules honor Emptier before that conversion.
 func IsEmpty(value interface{}) bool {
 	v := reflect.ValueOf(value)
+	if e, ok := value.(Emptier); ok && !(v.Kind() == reflect.Ptr && v.IsNil()) {
+		return e.IsEmpty()
+	}
 	switch v.Kind() {
 	case reflect.String, reflect.Array, reflect.Map, reflect.Slice:
 		return v.Len() == 0
@@ -126,6 +183,28 @@ func IsEmpty(value interface{}) bool {
 	return false
 }
 
+// checkEmpty reports whether a value is nil and whether it is empty. Unlike calling Indirect followed
+// by IsEmpty, it gives values implementing Emptier precedence over the driver.Valuer conversion.
+func checkEmpty(value interface{}) (isNil bool, empty bool) {
+	rv := reflect.ValueOf(value)
+	for rv.Kind() == reflect.Ptr || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return true, true
+		}
+		if e, ok := rv.Interface().(Emptier); ok {
+			return false, e.IsEmpty()
+		}
+		rv = rv.Elem()
+	}
+	if rv.IsValid() && rv.CanInterface() {
+		if e, ok := rv.Interface().(Emptier); ok {
+			return false, e.IsEmpty()
+		}
+	}
+	value, isNil = Indirect(value)
+	return isNil, isNil || IsEmpty(value)
+}
+
 // Indirect returns the value that the given interface or pointer references to.
 // If the value implements driver.Valuer, it will deal with the value returned by
 // the Value() method instead. A boolean value is also returned to indicate if
@@ -6,6 +6,8 @@ package validate
 
 import (
 	"database/sql"
+	"database/sql/driver"
+	"math"
 	"testing"
 	"time"
 
@@ -163,6 +165,29 @@ func TestToUint(t *testing.T) {
 	}
 }
 
+func TestToInt64(t *testing.T) {
+	tests := []struct {
+		tag    string
+		value  interface{}
+		result int64
+		err    string
+	}{
+		{"t1", -1, -1, ""},
+		{"t2", int8(-8), -8, ""},
+		{"t3", uint8(8), 8, ""},
+		{"t4", uint64(math.MaxInt64), math.MaxInt64, ""},
+		{"t5", uint64(math.MaxInt64) + 1, 0, "value overflows int64"},
+		{"t6", float64(1), 0, "cannot convert float64 to int64"},
+		{"t7", "abc", 0, "cannot convert string to int64"},
+	}
+
+	for _, test := range tests {
+		l, err := toInt64(test.value)
+		assert.Equal(t, test.result, l, test.tag)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
 func TestToFloat(t *testing.T) {
 	var a int
 	var b uint
@@ -191,6 +216,32 @@ func TestToFloat(t *testing.T) {
 	}
 }
 
+func TestToNumber(t *testing.T) {
+	var a int
+
+	tests := []struct {
+		tag    string
+		value  interface{}
+		result float64
+		err    string
+	}{
+		{"t1", 1, 1, ""},
+		{"t2", int8(-2), -2, ""},
+		{"t3", uint(3), 3, ""},
+		{"t4", float32(1.5), 1.5, ""},
+		{"t5", float64(2.5), 2.5, ""},
+		{"t6", &a, 0, "cannot convert ptr to a number"},
+		{"t7", "abc", 0, "cannot convert string to a number"},
+		{"t8", []int{1, 2}, 0, "cannot convert slice to a number"},
+	}
+
+	for _, test := range tests {
+		l, err := ToNumber(test.value)
+		assert.Equal(t, test.result, l, test.tag)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
 func TestIsEmpty(t *testing.T) {
 	var s1 string
 	var s2 = "a"
@@ -258,6 +309,13 @@ func TestIsEmpty(t *testing.T) {
 		{"t10.2", &time1, false},
 		{"t10.3", time2, true},
 		{"t10.4", &time2, true},
+		// Emptier
+		{"t11.1", celsius(0), false},
+		{"t11.2", &zeroCelsius, false},
+		{"t11.3", nilCelsius, true},
+		{"t11.4", nullString{String: "abc"}, true},
+		{"t11.5", nullString{Valid: true}, false},
+		{"t11.6", &nullString{String: "abc"}, true},
 	}
 
 	for _, test := range tests {
@@ -266,6 +324,61 @@ func TestIsEmpty(t *testing.T) {
 	}
 }
 
+// celsius is never empty, not even its zero value.
+type celsius int
+
+func (c celsius) IsEmpty() bool {
+	return false
+}
+
+var (
+	zeroCelsius celsius
+	nilCelsius  *celsius
+)
+
+// nullString is empty unless it is valid, regardless of its string.
+type nullString struct {
+	String string
+	Valid  bool
+}
+
+func (s nullString) IsEmpty() bool {
+	return !s.Valid
+}
+
+func (s nullString) Value() (driver.Value, error) {
+	if !s.Valid {
+		return "invalid", nil
+	}
+	return s.String, nil
+}
+
+func TestEmptier_Required(t *testing.T) {
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", celsius(0), ""},
+		{"t2", &zeroCelsius, ""},
+		{"t3", nilCelsius, "cannot be blank"},
+		// the IsEmpty method takes precedence over the driver.Valuer conversion
+		{"t4", nullString{String: "abc"}, "cannot be blank"},
+		{"t5", &nullString{String: "abc"}, "cannot be blank"},
+		{"t6", nullString{Valid: true}, ""},
+	}
+
+	for _, test := range tests {
+		assertError(t, test.err, Required.Validate(test.value), test.tag)
+	}
+
+	assert.Nil(t, NilOrNotEmpty.Validate(nilCelsius))
+	assert.Equal(t, ErrNilOrNotEmpty, NilOrNotEmpty.Validate(nullString{}))
+
+	// the zero value is not skipped by the rules treating empty values as valid
+	assertError(t, "must be no less than 10", Min(celsius(10)).Validate(celsius(0)), "min")
+}
+
 func TestIndirect(t *testing.T) {
 	var a = 100
 	var b *int
@@ -7,6 +7,7 @@ package validate
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
 	"strconv"
@@ -37,10 +38,21 @@ type (
 		ValidateWithContext(ctx context.Context, value interface{}) error
 	}
 
+	// Emptier is the interface implemented by types that determine by themselves whether their values are empty.
+	// IsEmpty and the Required rule defer to it before falling back to the reflection-based checks.
+	Emptier interface {
+		// IsEmpty reports whether the value is empty.
+		IsEmpty() bool
+	}
+
 	// RuleFunc represents a validator function.
 	// You may wrap it as a Rule by calling By().
 	RuleFunc func(value interface{}) error
 
+	// StructRuleFunc represents a validator function that receives the struct being validated by ValidateStruct
+	// along with the value of the field. You may wrap it as a Rule by calling ByStruct().
+	StructRuleFunc func(structPtr interface{}, value interface{}) error
+
 	// RuleWithContextFunc represents a validator function that is context-aware.
 	// You may wrap it as a Rule by calling WithContext().
 	RuleWithContextFunc func(ctx context.Context, value interface{}) error
@@ -51,8 +63,28 @@ var (
 	ErrorTag = "json"
 
 	// Skip is a special validation rule that indicates all rules following it should be skipped.
+	// Call When to skip them only if a condition is true, e.g. Skip.When(c.Country != "US").
 	Skip = skipRule{skip: true}
 
+	// NotRequired is a special validation rule that marks a value as optional: if the value is nil or empty,
+	// all rules following it are skipped; otherwise they are evaluated as usual. It is the inverse of Required,
+	// so a Required rule following it never fails. For example,
+	//    validation.Field(&c.Gender, validation.NotRequired, validation.In("Female", "Male"))
+	NotRequired = notRequiredRule{}
+
+	// Sensitive is a special validation rule that prevents the value from being attached to the errors
+	// returned by the rules following it, e.g. for passwords or secrets. For example,
+	//    validation.Field(&c.Password, validation.Sensitive, validation.Required, validation.Length(8, 64))
+	Sensitive = sensitiveRule{}
+
+	// ZeroIfNil is a special validation rule that replaces a nil pointer with a pointer to the zero value of
+	// its element type for the rules following it and for the validation of the value itself. It is mainly
+	// used with embedded struct pointers, which are skipped when nil by default. For example, if Manager embeds
+	// a nil *Employee implementing Validatable, the following reports the errors of a zero Employee, e.g.
+	// "Name: cannot be blank":
+	//    validation.Field(&m.Employee, validation.ZeroIfNil)
+	ZeroIfNil = zeroIfNilRule{}
+
 	validatableType            = reflect.TypeOf((*Validatable)(nil)).Elem()
 	validatableWithContextType = reflect.TypeOf((*ValidatableWithContext)(nil)).Elem()
 )
@@ -66,12 +98,22 @@ var (
 // 3. If the value being validated is a map/slice/array, and the element type implements `Validatable`,
 //    for each element call the element value's `Validate()`. Return with the validation result.
 func Validate(value interface{}, rules ...Rule) error {
+	sensitive := false
 	for _, rule := range rules {
 		if s, ok := rule.(skipRule); ok && s.skip {
 			return nil
 		}
+		if _, ok := rule.(sensitiveRule); ok {
+			sensitive = true
+		}
+		if _, ok := rule.(zeroIfNilRule); ok {
+			value = zeroIfNil(value)
+		}
+		if _, ok := rule.(notRequiredRule); ok && isNilOrEmpty(value) {
+			return nil
+		}
 		if err := rule.Validate(value); err != nil {
-			return err
+			return withValue(err, value, sensitive)
 		}
 	}
 
@@ -114,16 +156,26 @@ func Validate(value interface{}, rules ...Rule) error {
 // 5. If the value being validated is a map/slice/array, and the element type implements `Validatable`,
 //    for each element call the element value's `Validate()`. Return with the validation result.
 func ValidateWithContext(ctx context.Context, value interface{}, rules ...Rule) error {
+	sensitive := false
 	for _, rule := range rules {
 		if s, ok := rule.(skipRule); ok && s.skip {
 			return nil
 		}
+		if _, ok := rule.(sensitiveRule); ok {
+			sensitive = true
+		}
+		if _, ok := rule.(zeroIfNilRule); ok {
+			value = zeroIfNil(value)
+		}
+		if _, ok := rule.(notRequiredRule); ok && isNilOrEmpty(value) {
+			return nil
+		}
 		if rc, ok := rule.(RuleWithContext); ok {
 			if err := rc.ValidateWithContext(ctx, value); err != nil {
-				return err
+				return withValue(err, value, sensitive)
 			}
 		} else if err := rule.Validate(value); err != nil {
-			return err
+			return withValue(err, value, sensitive)
 		}
 	}
 
@@ -162,6 +214,55 @@ func ValidateWithContext(ctx context.Context, value interface{}, rules ...Rule)
 	return nil
 }
 
+// validateAll validates a value like ValidateWithContext, or like Validate if ctx is nil, but evaluates all rules
+// and returns the errors of the failing rules as FieldErrors. The value itself is validated only if all rules pass.
+// Internal errors are returned immediately.
+func validateAll(ctx context.Context, value interface{}, rules []Rule) error {
+	var errs FieldErrors
+	sensitive := false
+	for _, rule := range rules {
+		if s, ok := rule.(skipRule); ok && s.skip {
+			return errs.filter()
+		}
+		if _, ok := rule.(sensitiveRule); ok {
+			sensitive = true
+		}
+		if _, ok := rule.(zeroIfNilRule); ok {
+			value = zeroIfNil(value)
+		}
+		if _, ok := rule.(notRequiredRule); ok && isNilOrEmpty(value) {
+			return errs.filter()
+		}
+		var err error
+		if rc, ok := rule.(RuleWithContext); ok && ctx != nil {
+			err = rc.ValidateWithContext(ctx, value)
+		} else {
+			err = rule.Validate(value)
+		}
+		if err != nil {
+			if ie, ok := err.(InternalError); ok && ie.InternalError() != nil {
+				return err
+			}
+			errs = append(errs, withValue(err, value, sensitive))
+		}
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	if ctx == nil {
+		return Validate(value)
+	}
+	return ValidateWithContext(ctx, value)
+}
+
+// filter returns the FieldErrors as an error, or nil if there are no errors.
+func (es FieldErrors) filter() error {
+	if len(es) == 0 {
+		return nil
+	}
+	return es
+}
+
 // validateMap validates a map of validatable elements
 func validateMap(rv reflect.Value) error {
 	errs := Errors{}
@@ -242,6 +343,74 @@ func (r skipRule) When(condition bool) skipRule {
 	return r
 }
 
+type notRequiredRule struct{}
+
+func (r notRequiredRule) Validate(interface{}) error {
+	return nil
+}
+
+type sensitiveRule struct{}
+
+func (r sensitiveRule) Validate(interface{}) error {
+	return nil
+}
+
+type zeroIfNilRule struct{}
+
+func (r zeroIfNilRule) Validate(interface{}) error {
+	return nil
+}
+
+// zeroIfNil returns a pointer to a new zero value if the given value is a nil pointer, or the value otherwise.
+func zeroIfNil(value interface{}) interface{} {
+	if rv := reflect.ValueOf(value); rv.Kind() == reflect.Ptr && rv.IsNil() {
+		return reflect.New(rv.Type().Elem()).Interface()
+	}
+	return value
+}
+
+// withValue attaches the value that failed validation to an ErrorObject that does not carry one yet.
+// If the value is sensitive, the values attached by nested rules are removed instead.
+func withValue(err error, value interface{}, sensitive bool) error {
+	if sensitive {
+		return withoutValues(err)
+	}
+	if e, ok := err.(ErrorObject); ok && e.value == nil {
+		value, _ = Indirect(value)
+		return e.SetValue(value)
+	}
+	return err
+}
+
+// withoutValues returns a copy of the given error with the values removed from all ErrorObjects,
+// including those nested in Errors and FieldErrors.
+func withoutValues(err error) error {
+	switch e := err.(type) {
+	case ErrorObject:
+		e.value = nil
+		return e
+	case Errors:
+		res := make(Errors, len(e))
+		for key, err := range e {
+			res[key] = withoutValues(err)
+		}
+		return res
+	case FieldErrors:
+		res := make(FieldErrors, len(e))
+		for i, err := range e {
+			res[i] = withoutValues(err)
+		}
+		return res
+	}
+	return err
+}
+
+// isNilOrEmpty checks if a value is nil, a nil pointer or empty after dereferencing.
+func isNilOrEmpty(value interface{}) bool {
+	isNil, empty := checkEmpty(value)
+	return isNil || empty
+}
+
 type inlineRule struct {
 	f  RuleFunc
 	fc RuleWithContextFunc
@@ -270,3 +439,48 @@ func By(f RuleFunc) Rule {
 func WithContext(f RuleWithContextFunc) Rule {
 	return &inlineRule{fc: f}
 }
+
+// ByWithContext wraps a RuleWithContextFunc into a context-aware Rule. It is the context-aware
+// counterpart of By and is equivalent to WithContext. When the rule is evaluated through Validate
+// rather than ValidateWithContext, the function receives context.Background().
+func ByWithContext(f RuleWithContextFunc) Rule {
+	return WithContext(f)
+}
+
+// errByStructOutsideStruct is the error that a ByStruct rule is not evaluated by ValidateStruct.
+var errByStructOutsideStruct = errors.New("ByStruct rules can only be used with ValidateStruct")
+
+type structRule struct {
+	f         StructRuleFunc
+	structPtr interface{}
+}
+
+func (r structRule) bindStruct(structPtr interface{}, _ string) Rule {
+	r.structPtr = structPtr
+	return r
+}
+
+func (r structRule) Validate(value interface{}) error {
+	if r.structPtr == nil {
+		return NewInternalError(errByStructOutsideStruct)
+	}
+	return r.f(r.structPtr, value)
+}
+
+// ByStruct wraps a StructRuleFunc into a Rule that validates a struct field relative to the other fields
+// of its struct. The rule must be passed directly to Field; ValidateStruct then calls the function with
+// the struct pointer it was given, which the function may type-assert to the concrete pointer type.
+// For example,
+//    validation.ValidateStruct(&o,
+//        validation.Field(&o.MaxQuantity, validation.ByStruct(func(s interface{}, value interface{}) error {
+//            if value.(int) < s.(*Order).MinQuantity {
+//                return errors.New("must not be less than the minimum quantity")
+//            }
+//            return nil
+//        })),
+//    )
+//
+// Evaluating the rule in any other way, e.g. with Validate or nested in another rule, results in an internal error.
+func ByStruct(f StructRuleFunc) Rule {
+	return structRule{f: f}
+}
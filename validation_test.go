@@ -129,10 +129,270 @@ func TestByWithContext(t *testing.T) {
 	assert.NotNil(t, Validate("abc", abcRule))
 }
 
+func TestByWithContext_EntryPoints(t *testing.T) {
+	k := key(2)
+	var got context.Context
+	rule := ByWithContext(func(ctx context.Context, value interface{}) error {
+		got = ctx
+		if ctx.Value(k) != value {
+			return errors.New("must match the context value")
+		}
+		return nil
+	})
+
+	ctx := context.WithValue(context.Background(), k, "abc")
+	assert.Nil(t, ValidateWithContext(ctx, "abc", rule))
+	assert.Equal(t, ctx, got)
+	assert.EqualError(t, ValidateWithContext(ctx, "xyz", rule), "must match the context value")
+
+	// the non-context entry point falls back to a background context
+	assert.EqualError(t, Validate("abc", rule), "must match the context value")
+	assert.Equal(t, context.Background(), got)
+
+	type user struct {
+		Name string
+	}
+	u := user{Name: "abc"}
+	assert.Nil(t, ValidateStructWithContext(ctx, &u, Field(&u.Name, rule)))
+	assert.EqualError(t, ValidateStruct(&u, Field(&u.Name, rule)), "Name: must match the context value.")
+}
+
+func TestByStruct(t *testing.T) {
+	type order struct {
+		MinQuantity int
+		MaxQuantity int
+	}
+	maxRule := ByStruct(func(s interface{}, value interface{}) error {
+		if value.(int) < s.(*order).MinQuantity {
+			return errors.New("must not be less than the minimum quantity")
+		}
+		return nil
+	})
+	fields := func(o *order) []*FieldRules {
+		return []*FieldRules{Field(&o.MaxQuantity, Required, maxRule)}
+	}
+
+	o := order{MinQuantity: 5, MaxQuantity: 10}
+	assert.Nil(t, ValidateStruct(&o, fields(&o)...))
+	assert.Nil(t, ValidateStructWithContext(context.Background(), &o, fields(&o)...))
+
+	o.MaxQuantity = 3
+	assert.EqualError(t, ValidateStruct(&o, fields(&o)...), "MaxQuantity: must not be less than the minimum quantity.")
+
+	// the rule is bound to the struct being validated
+	o2 := order{MinQuantity: 1, MaxQuantity: 3}
+	assert.Nil(t, ValidateStruct(&o2, fields(&o2)...))
+
+	// outside ValidateStruct, the rule results in an internal error
+	err := Validate(3, maxRule)
+	if assert.NotNil(t, err) {
+		_, ok := err.(InternalError)
+		assert.True(t, ok)
+	}
+	err = ValidateStruct(&o, Field(&o.MaxQuantity, When(true, maxRule)))
+	if assert.NotNil(t, err) {
+		_, ok := err.(InternalError)
+		assert.True(t, ok)
+	}
+}
+
 func Test_skipRule_Validate(t *testing.T) {
 	assert.Nil(t, Skip.Validate(100))
 }
 
+func Test_skipRule_When(t *testing.T) {
+	var calls []string
+	rule := func(name string, err error) Rule {
+		return By(func(interface{}) error {
+			calls = append(calls, name)
+			return err
+		})
+	}
+
+	calls = nil
+	assert.Nil(t, Validate("x", rule("before", nil), Skip.When(true), rule("after", errors.New("after"))))
+	assert.Equal(t, []string{"before"}, calls)
+
+	calls = nil
+	assert.EqualError(t, Validate("x", rule("before", nil), Skip.When(false), rule("after", errors.New("after"))), "after")
+	assert.Equal(t, []string{"before", "after"}, calls)
+
+	// a rule before the conditional skip still fails the validation
+	calls = nil
+	assert.EqualError(t, Validate("x", rule("before", errors.New("before")), Skip.When(true), rule("after", nil)), "before")
+	assert.Equal(t, []string{"before"}, calls)
+
+	calls = nil
+	assert.Nil(t, ValidateWithContext(context.Background(), "x", rule("before", nil), Skip.When(true), rule("after", errors.New("after"))))
+	assert.Equal(t, []string{"before"}, calls)
+
+	assert.True(t, Skip.skip)
+	assert.False(t, Skip.When(false).skip)
+}
+
+func TestNotRequired(t *testing.T) {
+	var nilPtr *string
+	empty, male, other, zero, one := "", "Male", "Other", 0, 1
+
+	tests := []struct {
+		tag   string
+		value interface{}
+		rules []Rule
+		err   string
+	}{
+		{"t1", nil, []Rule{NotRequired, In("Female", "Male")}, ""},
+		{"t2", nilPtr, []Rule{NotRequired, In("Female", "Male")}, ""},
+		{"t3", "", []Rule{NotRequired, In("Female", "Male"), Length(5, 10)}, ""},
+		{"t4", &empty, []Rule{NotRequired, By(func(interface{}) error { return errors.New("called") })}, ""},
+		{"t5", "Male", []Rule{NotRequired, In("Female", "Male")}, ""},
+		{"t6", &male, []Rule{NotRequired, In("Female", "Male")}, ""},
+		{"t7", "Other", []Rule{NotRequired, In("Female", "Male")}, "must be a valid value"},
+		{"t8", &other, []Rule{NotRequired, In("Female", "Male")}, "must be a valid value"},
+		{"t9", 0, []Rule{NotRequired, By(func(interface{}) error { return errors.New("called") })}, ""},
+		{"t10", &zero, []Rule{NotRequired, Min(5)}, ""},
+		{"t11", &one, []Rule{NotRequired, Min(5)}, "must be no less than 5"},
+		// a Required rule following NotRequired never fails
+		{"t12", "", []Rule{NotRequired, Required}, ""},
+		// rules preceding NotRequired are still evaluated
+		{"t13", "", []Rule{Required, NotRequired}, "cannot be blank"},
+		{"t14", String123("abc"), []Rule{NotRequired}, "error 123"},
+	}
+
+	for _, test := range tests {
+		err := Validate(test.value, test.rules...)
+		assertError(t, test.err, err, test.tag)
+		err = ValidateWithContext(context.Background(), test.value, test.rules...)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	assert.Nil(t, NotRequired.Validate(100))
+}
+
+func TestNotRequired_ValidateStruct(t *testing.T) {
+	c := struct {
+		Gender string
+		Age    *int
+	}{}
+	assert.Nil(t, ValidateStruct(&c,
+		Field(&c.Gender, NotRequired, In("Female", "Male")),
+		Field(&c.Age, NotRequired, Min(18)),
+	))
+
+	age := 12
+	c.Gender, c.Age = "Other", &age
+	assert.EqualError(t, ValidateStruct(&c,
+		Field(&c.Gender, NotRequired, In("Female", "Male")),
+		Field(&c.Age, NotRequired, Min(18)),
+	), "Age: must be no less than 18; Gender: must be a valid value.")
+}
+
+func TestValidate_Value(t *testing.T) {
+	name := "ab"
+	err := Validate(&name, Length(3, 10))
+	if e, ok := err.(ErrorObject); assert.True(t, ok) {
+		assert.Equal(t, "ab", e.Value())
+	}
+
+	err = ValidateWithContext(context.Background(), "xyz", WithContext(func(ctx context.Context, value interface{}) error {
+		return NewError("code", "invalid")
+	}))
+	if e, ok := err.(ErrorObject); assert.True(t, ok) {
+		assert.Equal(t, "xyz", e.Value())
+	}
+
+	// a value set by the rule itself is kept
+	err = Validate("abc", By(func(value interface{}) error {
+		return NewError("code", "invalid").(ErrorObject).SetValue("b")
+	}))
+	if e, ok := err.(ErrorObject); assert.True(t, ok) {
+		assert.Equal(t, "b", e.Value())
+	}
+
+	// errors other than ErrorObject are returned unchanged
+	assert.Equal(t, errors.New("error abc"), Validate("xyz", &validateAbc{}))
+
+	err = Validate("secret", Sensitive, Length(10, 0))
+	if e, ok := err.(ErrorObject); assert.True(t, ok) {
+		assert.Nil(t, e.Value())
+	}
+}
+
+func TestValidate_ValueNested(t *testing.T) {
+	type credentials struct {
+		User     string
+		Password string
+	}
+	c := struct {
+		Credentials credentials
+		Labels      map[string]interface{}
+	}{
+		Credentials: credentials{"x", "secret"},
+		Labels:      map[string]interface{}{"env": "qa", "tier": 5},
+	}
+
+	err := ValidateStruct(&c,
+		Field(&c.Credentials, By(func(interface{}) error {
+			return ValidateStruct(&c.Credentials,
+				Field(&c.Credentials.User, Length(3, 0)),
+				Field(&c.Credentials.Password, Sensitive, Length(10, 0)),
+			)
+		})),
+		Field(&c.Labels, Map(
+			Key("env", In("dev", "prod")),
+			Key("tier", Max(3)),
+		)),
+	)
+
+	errs, ok := err.(Errors)
+	if !assert.True(t, ok) {
+		return
+	}
+	value := func(err error, keys ...string) interface{} {
+		for _, key := range keys {
+			err = err.(Errors)[key]
+		}
+		return err.(ErrorObject).Value()
+	}
+	assert.Equal(t, "x", value(errs, "Credentials", "User"))
+	assert.Nil(t, value(errs, "Credentials", "Password"))
+	assert.Equal(t, "qa", value(errs, "Labels", "env"))
+	assert.Equal(t, 5, value(errs, "Labels", "tier"))
+}
+
+func TestValidate_SensitiveNested(t *testing.T) {
+	password := "pw1"
+	s := struct {
+		Secrets   map[string]interface{}
+		Passwords []string
+		Password  string
+		Optional  *string
+	}{
+		Secrets:   map[string]interface{}{"token": "hunter2"},
+		Passwords: []string{"pw1"},
+		Password:  password,
+		Optional:  &password,
+	}
+
+	err := ValidateStruct(&s,
+		Field(&s.Secrets, Sensitive, Map(Key("token", Length(10, 0)))),
+		Field(&s.Passwords, Sensitive, Each(Length(10, 0))),
+		Field(&s.Password, Sensitive, When(true, Length(10, 0))),
+		Field(&s.Optional, Sensitive, OptionalPtr(Length(10, 0))),
+	)
+
+	errs, ok := err.(Errors)
+	if !assert.True(t, ok) {
+		return
+	}
+	assert.Nil(t, errs["Secrets"].(Errors)["token"].(ErrorObject).Value())
+	assert.Nil(t, errs["Passwords"].(Errors)["0"].(ErrorObject).Value())
+	assert.Nil(t, errs["Password"].(ErrorObject).Value())
+	assert.Nil(t, errs["Optional"].(ErrorObject).Value())
+
+	err = Validate([]string{"pw1"}, Sensitive, Each(Length(10, 0)))
+	assert.Nil(t, err.(Errors)["0"].(ErrorObject).Value())
+}
+
 func assertError(t *testing.T, expected string, err error, tag string) {
 	if expected == "" {
 		assert.NoError(t, err, tag)
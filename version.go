@@ -0,0 +1,89 @@
+package validate
+
+import (
+	"context"
+	"fmt"
+)
+
+// ErrUnsupportedVersion is the error that returns when a schema version is not supported.
+var ErrUnsupportedVersion = NewError("validation_unsupported_version", "unsupported schema version {{.version}}")
+
+// SupportedVersion returns a validation rule that checks if a version is one of the supported versions.
+// The version may be of any type, e.g. a string or an int, and is compared with its fmt.Sprint representation.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func SupportedVersion(supported ...string) VersionRule {
+	fields := make(map[string][]*FieldRules, len(supported))
+	for _, version := range supported {
+		fields[version] = nil
+	}
+	return VersionRule{
+		fields: fields,
+		err:    ErrUnsupportedVersion,
+	}
+}
+
+// VersionSwitch returns a validation rule that validates a struct with the field rules of the version
+// declared by the version field that versionPtr points to. The rule must be applied to a pointer to the struct
+// and fails if the version has no field rules. For example,
+//    err := validation.Validate(&p, validation.VersionSwitch(&p.SchemaVersion, map[string][]*validation.FieldRules{
+//        "1": {validation.Field(&p.Name, validation.Required)},
+//        "2": {validation.Field(&p.FirstName, validation.Required), validation.Field(&p.LastName, validation.Required)},
+//    }))
+//
+// Note that Validate also calls the Validate method of a struct implementing Validatable after the rules pass,
+// so calling VersionSwitch through Validate from that method would recurse. Call the rule's Validate method instead.
+// If the version is empty, the struct is considered valid. Use the Required rule to make sure a version is present.
+func VersionSwitch(versionPtr interface{}, fields map[string][]*FieldRules) VersionRule {
+	return VersionRule{
+		versionPtr: versionPtr,
+		fields:     fields,
+		err:        ErrUnsupportedVersion,
+	}
+}
+
+// VersionRule is a validation rule that checks if a version is supported.
+type VersionRule struct {
+	versionPtr interface{}
+	fields     map[string][]*FieldRules
+	err        Error
+}
+
+// Validate checks if the given value is valid or not.
+func (r VersionRule) Validate(value interface{}) error {
+	return r.ValidateWithContext(nil, value)
+}
+
+// ValidateWithContext checks if the given value is valid or not.
+// For VersionSwitch, the struct fields are validated with the given context.
+func (r VersionRule) ValidateWithContext(ctx context.Context, value interface{}) error {
+	version := value
+	if r.versionPtr != nil {
+		version = r.versionPtr
+	}
+	version, isNil := Indirect(version)
+	if isNil || IsEmpty(version) {
+		return nil
+	}
+
+	key := fmt.Sprint(version)
+	fields, ok := r.fields[key]
+	if !ok {
+		return r.err.SetParams(map[string]interface{}{"version": key})
+	}
+	if r.versionPtr == nil {
+		return nil
+	}
+	return ValidateStructWithContext(ctx, value, fields...)
+}
+
+// Error sets the error message for the rule.
+func (r VersionRule) Error(message string) VersionRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r VersionRule) ErrorObject(err Error) VersionRule {
+	r.err = err
+	return r
+}
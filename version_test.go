@@ -0,0 +1,88 @@
+package validate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSupportedVersion(t *testing.T) {
+	var nilPtr *string
+	v2 := "2"
+
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", "", ""},
+		{"t2", nilPtr, ""},
+		{"t3", "1", ""},
+		{"t4", &v2, ""},
+		{"t5", "3", "unsupported schema version 3"},
+		{"t6", 2, ""},
+		{"t7", 3, "unsupported schema version 3"},
+	}
+
+	for _, test := range tests {
+		err := SupportedVersion("1", "2").Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestVersionSwitch(t *testing.T) {
+	p := struct {
+		SchemaVersion int
+		Name          string
+		FirstName     string
+	}{}
+	r := VersionSwitch(&p.SchemaVersion, map[string][]*FieldRules{
+		"1": {Field(&p.Name, Required)},
+		"2": {Field(&p.FirstName, Required)},
+	})
+
+	tests := []struct {
+		tag     string
+		version int
+		name    string
+		err     string
+	}{
+		{"t1", 0, "", ""},
+		{"t2", 1, "", "Name: cannot be blank."},
+		{"t3", 1, "a", ""},
+		{"t4", 2, "a", "FirstName: cannot be blank."},
+		{"t5", 3, "", "unsupported schema version 3"},
+	}
+
+	for _, test := range tests {
+		p.SchemaVersion, p.Name = test.version, test.name
+		err := Validate(&p, r)
+		assertError(t, test.err, err, test.tag)
+	}
+
+	p.SchemaVersion = 1
+	assert.EqualError(t, r.ValidateWithContext(context.Background(), &p), "Name: cannot be blank.")
+
+	// the rule must be applied to a pointer to the struct
+	err := r.Validate(p)
+	if assert.NotNil(t, err) {
+		_, ok := err.(InternalError)
+		assert.True(t, ok)
+	}
+}
+
+func TestVersionRule_Error(t *testing.T) {
+	r := SupportedVersion("1").Error("version {{.version}} is not supported")
+	assert.Equal(t, "version 2 is not supported", r.Validate("2").Error())
+}
+
+func TestVersionRule_ErrorObject(t *testing.T) {
+	r := SupportedVersion("1")
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
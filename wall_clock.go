@@ -0,0 +1,134 @@
+package validate
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+)
+
+var (
+	// ErrWallClockNonexistent is the error that returns when a wall-clock time falls into a gap of a time zone.
+	ErrWallClockNonexistent = NewError("validation_wall_clock_nonexistent", "time does not exist in {{.zone}} on that date")
+	// ErrWallClockAmbiguous is the error that returns when a wall-clock time occurs twice in a time zone.
+	ErrWallClockAmbiguous = NewError("validation_wall_clock_ambiguous", "time is ambiguous in {{.zone}} on that date")
+)
+
+// defaultWallClockLayout is the layout used by ValidWallClock to parse strings unless Layout is called.
+const defaultWallClockLayout = "2006-01-02T15:04:05"
+
+// ValidWallClock returns a validation rule that checks if a naive date and time, i.e. one without a UTC offset,
+// denotes exactly one instant in the given location. It fails for times in a gap when clocks are set forward,
+// such as 02:30 on the day daylight saving time starts, and for times that occur twice when clocks are set
+// back, such as 01:30 on the day it ends. Call AllowAmbiguous to accept the latter.
+//
+// Strings are parsed with the layout "2006-01-02T15:04:05", which can be changed by calling Layout; strings that
+// cannot be parsed result in ErrDateInvalid. For time.Time values, only the date and clock fields are used.
+// If loc is nil, UTC is used.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func ValidWallClock(loc *time.Location) WallClockRule {
+	if loc == nil {
+		loc = time.UTC
+	}
+	return WallClockRule{
+		loc:          loc,
+		layout:       defaultWallClockLayout,
+		err:          ErrWallClockNonexistent,
+		ambiguousErr: ErrWallClockAmbiguous,
+	}
+}
+
+// WallClockRule is a validation rule that checks if a wall-clock time exists and is unambiguous in a location.
+type WallClockRule struct {
+	loc               *time.Location
+	layout            string
+	allowAmbiguous    bool
+	err, ambiguousErr Error
+}
+
+// Layout sets the layout used to parse strings, which accepts the same values as time.Parse.
+func (r WallClockRule) Layout(layout string) WallClockRule {
+	r.layout = layout
+	return r
+}
+
+// AllowAmbiguous makes the rule accept wall-clock times that occur twice in the location.
+func (r WallClockRule) AllowAmbiguous() WallClockRule {
+	r.allowAmbiguous = true
+	return r
+}
+
+// Validate checks if the given value is valid or not.
+func (r WallClockRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	var naive time.Time
+	switch v := value.(type) {
+	case time.Time:
+		naive = v
+	default:
+		str, err := EnsureString(value)
+		if err != nil {
+			return fmt.Errorf("cannot convert %v to time.Time", reflect.TypeOf(value))
+		}
+		if naive, err = time.Parse(r.layout, str); err != nil {
+			return ErrDateInvalid
+		}
+	}
+
+	// Interpret the wall clock as UTC and try the offsets in effect a day before and after, since time zone
+	// transitions are at least that far apart. Each offset that maps back to the same wall clock gives one instant.
+	y, mo, d := naive.Date()
+	h, mi, s := naive.Clock()
+	u := time.Date(y, mo, d, h, mi, s, naive.Nanosecond(), time.UTC)
+	_, before := u.Add(-24 * time.Hour).In(r.loc).Zone()
+	_, after := u.Add(24 * time.Hour).In(r.loc).Zone()
+
+	matches := 0
+	for i, offset := range []int{before, after} {
+		if i == 1 && offset == before {
+			break
+		}
+		t := u.Add(-time.Duration(offset) * time.Second).In(r.loc)
+		if ty, tmo, td := t.Date(); ty == y && tmo == mo && td == d {
+			if th, tmi, ts := t.Clock(); th == h && tmi == mi && ts == s {
+				matches++
+			}
+		}
+	}
+
+	params := map[string]interface{}{"zone": r.loc.String()}
+	switch {
+	case matches == 0:
+		return r.err.SetParams(params)
+	case matches > 1 && !r.allowAmbiguous:
+		return r.ambiguousErr.SetParams(params)
+	}
+	return nil
+}
+
+// Error sets the error message that is used when the time does not exist in the location.
+func (r WallClockRule) Error(message string) WallClockRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct that is used when the time does not exist in the location.
+func (r WallClockRule) ErrorObject(err Error) WallClockRule {
+	r.err = err
+	return r
+}
+
+// AmbiguousError sets the error message that is used when the time is ambiguous in the location.
+func (r WallClockRule) AmbiguousError(message string) WallClockRule {
+	r.ambiguousErr = r.ambiguousErr.SetMessage(message)
+	return r
+}
+
+// AmbiguousErrorObject sets the error struct that is used when the time is ambiguous in the location.
+func (r WallClockRule) AmbiguousErrorObject(err Error) WallClockRule {
+	r.ambiguousErr = err
+	return r
+}
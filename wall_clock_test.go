@@ -0,0 +1,80 @@
+package validate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidWallClock(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if !assert.Nil(t, err) {
+		return
+	}
+	gap := time.Date(2024, 3, 10, 2, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", "", ""},
+		{"t2", "2024-03-10T01:59:59", ""},
+		{"t3", "2024-03-10T02:30:00", "time does not exist in America/New_York on that date"},
+		{"t4", "2024-03-10T03:00:00", ""},
+		{"t5", "2024-11-03T00:59:59", ""},
+		{"t6", "2024-11-03T01:30:00", "time is ambiguous in America/New_York on that date"},
+		{"t7", "2024-11-03T02:00:00", ""},
+		{"t8", "2024-07-01T12:00:00", ""},
+		{"t9", gap, "time does not exist in America/New_York on that date"},
+		{"t10", &gap, "time does not exist in America/New_York on that date"},
+		{"t11", "2024-03-10 02:30", "must be a valid date"},
+		{"t12", 123, "cannot convert int to time.Time"},
+	}
+
+	for _, test := range tests {
+		err := ValidWallClock(ny).Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestWallClockRule_Options(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	r := ValidWallClock(ny).Layout("2006-01-02 15:04")
+	assert.Nil(t, r.Validate("2024-03-10 01:30"))
+	assert.NotNil(t, r.Validate("2024-03-10 02:30"))
+
+	r = r.AllowAmbiguous()
+	assert.Nil(t, r.Validate("2024-11-03 01:30"))
+	assert.NotNil(t, r.Validate("2024-03-10 02:30"))
+
+	assert.Nil(t, ValidWallClock(time.UTC).Validate("2024-03-10T02:30:00"))
+	assert.Nil(t, ValidWallClock(nil).Validate("2020-03-08T02:30:00"))
+}
+
+func TestWallClockRule_Error(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if !assert.Nil(t, err) {
+		return
+	}
+
+	r := ValidWallClock(ny).Error("skipped in {{.zone}}").AmbiguousError("repeated")
+	assert.Equal(t, "skipped in America/New_York", r.Validate("2024-03-10T02:30:00").Error())
+	assert.Equal(t, "repeated", r.Validate("2024-11-03T01:30:00").Error())
+}
+
+func TestWallClockRule_ErrorObject(t *testing.T) {
+	r := ValidWallClock(time.UTC)
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err).AmbiguousErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err, r.ambiguousErr)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
@@ -2,6 +2,9 @@ package validate
 
 import "context"
 
+// ErrWhen is the error that returns when the rules of a WhenRule fail and a custom error message is set.
+var ErrWhen = NewError("validation_when", "")
+
 // When returns a validation rule that executes the given list of rules when the condition is true.
 func When(condition bool, rules ...Rule) WhenRule {
 	return WhenRule{
@@ -16,6 +19,7 @@ type WhenRule struct {
 	condition bool
 	rules     []Rule
 	elseRules []Rule
+	err       Error
 }
 
 // Validate checks if the condition is true and if so, it validates the value using the specified rules.
@@ -25,6 +29,18 @@ func (r WhenRule) Validate(value interface{}) error {
 
 // ValidateWithContext checks if the condition is true and if so, it validates the value using the specified rules.
 func (r WhenRule) ValidateWithContext(ctx context.Context, value interface{}) error {
+	err := r.validate(ctx, value)
+	if err == nil || r.err == nil {
+		return err
+	}
+	if e, ok := err.(InternalError); ok && e.InternalError() != nil {
+		return err
+	}
+	return r.err
+}
+
+// validate validates the value using the rules of the branch selected by the condition.
+func (r WhenRule) validate(ctx context.Context, value interface{}) error {
 	if r.condition {
 		if ctx == nil {
 			return Validate(value, r.rules...)
@@ -43,3 +59,18 @@ func (r WhenRule) Else(rules ...Rule) WhenRule {
 	r.elseRules = rules
 	return r
 }
+
+// Error sets the error message that replaces the first error returned by the rules of the evaluated branch.
+func (r WhenRule) Error(message string) WhenRule {
+	if r.err == nil {
+		r.err = ErrWhen
+	}
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct that replaces the first error returned by the rules of the evaluated branch.
+func (r WhenRule) ErrorObject(err Error) WhenRule {
+	r.err = err
+	return r
+}
@@ -9,6 +9,8 @@ import (
 	"errors"
 	"strings"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func abcValidation(val string) bool {
@@ -98,3 +100,32 @@ func TestWhenWithContext(t *testing.T) {
 		assertError(t, test.err, err, test.tag)
 	}
 }
+
+func TestWhenRule_Error(t *testing.T) {
+	r := When(true, Required, Length(3, 5)).Else(Empty).Error("this field is mandatory when X is set")
+	assert.Equal(t, "this field is mandatory when X is set", Validate("", r).Error())
+	assert.Equal(t, "this field is mandatory when X is set", Validate("ab", r).Error())
+	assert.Nil(t, Validate("abc", r))
+	assert.Equal(t, ErrWhen.Code(), r.err.Code())
+
+	r.condition = false
+	assert.Equal(t, "this field is mandatory when X is set", Validate("ab", r).Error())
+	assert.Nil(t, Validate("", r))
+
+	// internal errors are not replaced
+	internal := By(func(interface{}) error { return NewInternalError(errors.New("abc")) })
+	assert.Equal(t, "abc", Validate("", When(true, internal).Error("xyz")).Error())
+
+	// without a custom error, the original error is returned
+	assert.Equal(t, "cannot be blank", Validate("", When(true, Required)).Error())
+}
+
+func TestWhenRule_ErrorObject(t *testing.T) {
+	err := NewError("code", "abc")
+	r := When(false).Else(Required).ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+	assert.Equal(t, "abc", Validate("", r).Error())
+}
@@ -0,0 +1,68 @@
+package validate
+
+import (
+	"strings"
+	"unicode"
+)
+
+// ErrWordsIn is the error that returns when a string contains words that are not in a dictionary.
+var ErrWordsIn = NewError("validation_words_in", "unknown tag: {{.tags}}")
+
+// WordsIn returns a validation rule that splits a string into words and checks if every word is in the dictionary.
+// Words are separated by whitespace and/or commas. The unknown words are reported, separated by commas,
+// in the "tags" parameter of the error.
+// This rule should only be used for validating strings and byte slices.
+// An empty value is considered valid. Use the Required rule to make sure a value is not empty.
+func WordsIn(dictionary map[string]bool) WordsInRule {
+	return WordsInRule{
+		dictionary: dictionary,
+		err:        ErrWordsIn,
+	}
+}
+
+// WordsInRule is a validation rule that checks if every word of a string is in a dictionary.
+type WordsInRule struct {
+	dictionary map[string]bool
+	err        Error
+}
+
+// Validate checks if the given value is valid or not.
+func (r WordsInRule) Validate(value interface{}) error {
+	value, isNil := Indirect(value)
+	if isNil || IsEmpty(value) {
+		return nil
+	}
+
+	str, err := EnsureString(value)
+	if err != nil {
+		return err
+	}
+
+	var unknown []string
+	for _, word := range strings.FieldsFunc(str, isWordSeparator) {
+		if !r.dictionary[word] {
+			unknown = append(unknown, word)
+		}
+	}
+
+	if len(unknown) > 0 {
+		return r.err.SetParams(map[string]interface{}{"tags": strings.Join(unknown, ", ")})
+	}
+	return nil
+}
+
+// Error sets the error message for the rule.
+func (r WordsInRule) Error(message string) WordsInRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct for the rule.
+func (r WordsInRule) ErrorObject(err Error) WordsInRule {
+	r.err = err
+	return r
+}
+
+func isWordSeparator(c rune) bool {
+	return c == ',' || unicode.IsSpace(c)
+}
@@ -0,0 +1,52 @@
+package validate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWordsIn(t *testing.T) {
+	dict := map[string]bool{"go": true, "rust": true, "zig": true}
+
+	tests := []struct {
+		tag   string
+		value interface{}
+		err   string
+	}{
+		{"t1", "", ""},
+		{"t2", "go", ""},
+		{"t3", "go rust", ""},
+		{"t4", "go, rust,zig", ""},
+		{"t5", " go ,\n\trust ", ""},
+		{"t6", "go, foo", "unknown tag: foo"},
+		{"t7", "foo bar go", "unknown tag: foo, bar"},
+		{"t8", "Go", "unknown tag: Go"},
+		{"t9", []byte("zig"), ""},
+		{"t10", 123, "must be either a string or byte slice"},
+	}
+
+	for _, test := range tests {
+		r := WordsIn(dict)
+		err := r.Validate(test.value)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestWordsInRule_Error(t *testing.T) {
+	r := WordsIn(nil)
+	assert.Equal(t, "unknown tag: a", r.Validate("a").Error())
+
+	r = r.Error("not allowed: {{.tags}}")
+	assert.Equal(t, "not allowed: a, b", r.Validate("a,b").Error())
+}
+
+func TestWordsInRule_ErrorObject(t *testing.T) {
+	r := WordsIn(nil)
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}
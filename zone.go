@@ -0,0 +1,94 @@
+package validate
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+)
+
+var (
+	// ErrZoneInconsistent is the error that returns when the offset of a time does not match a named time zone.
+	ErrZoneInconsistent = NewError("validation_zone_inconsistent", "timezone offset is inconsistent with the zone name")
+	// ErrZoneUnknown is the error that returns when a time zone name cannot be loaded.
+	ErrZoneUnknown = NewError("validation_zone_unknown", "unknown timezone {{.zone}}")
+)
+
+// ZoneConsistent returns a validation rule that checks if the UTC offset of a time agrees with a named
+// IANA time zone at that instant, taking daylight saving time into account.
+// timePtr should point to a time.Time field and zonePtr to a string field holding the zone name,
+// e.g. "America/New_York", which makes the rule usable within ValidateStruct. The value the rule is
+// attached to is not used, so it is typically attached to either of the two fields:
+//    validation.Field(&e.Zone, validation.ZoneConsistent(&e.StartsAt, &e.Zone))
+//
+// Zone names are loaded with time.LoadLocation and thus depend on the time zone database of the system.
+// The rule is skipped if either the time or the zone name is empty.
+func ZoneConsistent(timePtr, zonePtr interface{}) ZoneRule {
+	return ZoneRule{
+		time:    timePtr,
+		zone:    zonePtr,
+		err:     ErrZoneInconsistent,
+		zoneErr: ErrZoneUnknown,
+	}
+}
+
+// ZoneRule is a validation rule that checks if the offset of a time is consistent with a named time zone.
+type ZoneRule struct {
+	time, zone   interface{}
+	err, zoneErr Error
+}
+
+// Validate checks if the referenced time and zone are consistent.
+func (r ZoneRule) Validate(interface{}) error {
+	tv, isNil := Indirect(r.time)
+	if isNil || IsEmpty(tv) {
+		return nil
+	}
+	t, ok := tv.(time.Time)
+	if !ok {
+		return fmt.Errorf("cannot convert %v to time.Time", reflect.TypeOf(tv))
+	}
+
+	zv, isNil := Indirect(r.zone)
+	if isNil || IsEmpty(zv) {
+		return nil
+	}
+	zone, err := EnsureString(zv)
+	if err != nil {
+		return err
+	}
+
+	loc, err := time.LoadLocation(zone)
+	if err != nil {
+		return r.zoneErr.SetParams(map[string]interface{}{"zone": zone})
+	}
+
+	_, offset := t.Zone()
+	if _, expected := t.In(loc).Zone(); offset != expected {
+		return r.err
+	}
+	return nil
+}
+
+// Error sets the error message that is used when the offset is inconsistent with the zone.
+func (r ZoneRule) Error(message string) ZoneRule {
+	r.err = r.err.SetMessage(message)
+	return r
+}
+
+// ErrorObject sets the error struct that is used when the offset is inconsistent with the zone.
+func (r ZoneRule) ErrorObject(err Error) ZoneRule {
+	r.err = err
+	return r
+}
+
+// ZoneError sets the error message that is used when the zone name cannot be loaded.
+func (r ZoneRule) ZoneError(message string) ZoneRule {
+	r.zoneErr = r.zoneErr.SetMessage(message)
+	return r
+}
+
+// ZoneErrorObject sets the error struct that is used when the zone name cannot be loaded.
+func (r ZoneRule) ZoneErrorObject(err Error) ZoneRule {
+	r.zoneErr = err
+	return r
+}
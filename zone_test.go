@@ -0,0 +1,79 @@
+package validate
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestZoneConsistent(t *testing.T) {
+	est := time.FixedZone("", -5*60*60)
+	edt := time.FixedZone("", -4*60*60)
+	winter := time.Date(2024, 1, 15, 12, 0, 0, 0, est)
+	summer := time.Date(2024, 7, 15, 12, 0, 0, 0, edt)
+	summerEST := time.Date(2024, 7, 15, 12, 0, 0, 0, est)
+	var zeroTime time.Time
+	var nilZone *string
+	ny, utc, empty, unknown := "America/New_York", "UTC", "", "Mars/Olympus"
+	notTime := 1
+
+	tests := []struct {
+		tag  string
+		time interface{}
+		zone interface{}
+		err  string
+	}{
+		{"t1", &winter, &ny, ""},
+		{"t2", &summer, &ny, ""},
+		{"t3", &summerEST, &ny, "timezone offset is inconsistent with the zone name"},
+		{"t4", &winter, &utc, "timezone offset is inconsistent with the zone name"},
+		{"t5", &zeroTime, &ny, ""},
+		{"t6", &winter, &empty, ""},
+		{"t7", &winter, nilZone, ""},
+		{"t8", &winter, &unknown, "unknown timezone Mars/Olympus"},
+		{"t9", &notTime, &ny, "cannot convert int to time.Time"},
+		{"t10", &winter, &notTime, "must be either a string or byte slice"},
+	}
+
+	for _, test := range tests {
+		r := ZoneConsistent(test.time, test.zone)
+		err := r.Validate(nil)
+		assertError(t, test.err, err, test.tag)
+	}
+}
+
+func TestZoneConsistent_ValidateStruct(t *testing.T) {
+	e := struct {
+		StartsAt time.Time
+		Zone     string
+	}{time.Date(2024, 7, 15, 12, 0, 0, 0, time.UTC), "Europe/London"}
+
+	err := ValidateStruct(&e, Field(&e.Zone, ZoneConsistent(&e.StartsAt, &e.Zone)))
+	assert.EqualError(t, err, "Zone: timezone offset is inconsistent with the zone name.")
+
+	e.StartsAt = time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+	assert.Nil(t, ValidateStruct(&e, Field(&e.Zone, ZoneConsistent(&e.StartsAt, &e.Zone))))
+}
+
+func TestZoneRule_Error(t *testing.T) {
+	now := time.Date(2024, 1, 15, 12, 0, 0, 0, time.FixedZone("", 3600))
+	utc, unknown := "UTC", "abc"
+
+	r := ZoneConsistent(&now, &utc).Error("bad offset")
+	assert.Equal(t, "bad offset", r.Validate(nil).Error())
+
+	r = ZoneConsistent(&now, &unknown).ZoneError("no zone {{.zone}}")
+	assert.Equal(t, "no zone abc", r.Validate(nil).Error())
+}
+
+func TestZoneRule_ErrorObject(t *testing.T) {
+	r := ZoneConsistent(nil, nil)
+	err := NewError("code", "abc")
+	r = r.ErrorObject(err).ZoneErrorObject(err)
+
+	assert.Equal(t, err, r.err)
+	assert.Equal(t, err, r.zoneErr)
+	assert.Equal(t, err.Code(), r.err.Code())
+	assert.Equal(t, err.Message(), r.err.Message())
+}